@@ -0,0 +1,129 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// Request is a GraphQL document along with the variables it references.
+type Request struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// Client executes GraphQL requests against a GitHub host and returns the raw
+// response body. Errors reported in the body are left for the caller to decode.
+type Client interface {
+	Execute(ctx context.Context, request Request) ([]byte, error)
+}
+
+// HTTPError is returned when the GraphQL endpoint responds with a non-success
+// status code.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (e *HTTPError) Error() string {
+	body := strings.TrimSpace(string(e.Body))
+	if body == "" {
+		return fmt.Sprintf("HTTP %s", e.Status)
+	}
+	return fmt.Sprintf("HTTP %s: %s", e.Status, body)
+}
+
+// GraphQLErrorItem is a single entry in the errors array of a GraphQL
+// response.
+type GraphQLErrorItem struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	Path    []any  `json:"path"`
+}
+
+// GraphQLError is returned when a GraphQL response contains an errors array.
+type GraphQLError struct {
+	Errors []GraphQLErrorItem
+}
+
+func (e *GraphQLError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, item := range e.Errors {
+		messages = append(messages, item.Message)
+	}
+	return "GraphQL: " + strings.Join(messages, "; ")
+}
+
+// DecodeError is returned when a response body cannot be decoded.
+type DecodeError struct {
+	Body []byte
+	Err  error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to decode response: %s", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// execute sends the given request through the client and decodes the response
+// body as T.
+func execute[T any](ctx context.Context, client Client, request Request) result.Result[T] {
+	body, err := client.Execute(ctx, request)
+	if err != nil {
+		return result.Error[T](err)
+	}
+	return decode[T](body)
+}
+
+// decode unmarshals a GraphQL response body as T. A body carrying an errors
+// array is returned as a GraphQLError.
+func decode[T any](body []byte) result.Result[T] {
+	var errors struct {
+		Errors []GraphQLErrorItem `json:"errors"`
+	}
+	err := json.Unmarshal(body, &errors)
+	if err != nil {
+		return result.Error[T](&DecodeError{Body: body, Err: err})
+	}
+	if len(errors.Errors) != 0 {
+		return result.Error[T](&GraphQLError{Errors: errors.Errors})
+	}
+	var response T
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return result.Error[T](&DecodeError{Body: body, Err: err})
+	}
+	return result.Ok(response)
+}
+
+// mutationResults holds the payloads of a mutation response keyed by the name
+// of each mutation.
+type mutationResults struct {
+	Data map[string]json.RawMessage `json:"data"`
+}
+
+// mutate runs a mutation whose payload has a pullRequest field and returns
+// that pull request.
+func mutate(ctx context.Context, client Client, name string, query string, variables map[string]any) result.Result[PullRequest] {
+	response := execute[mutationResults](ctx, client, Request{
+		Query:     query + pullRequestFragment,
+		Variables: variables,
+	})
+	return result.FlatMap(func(r mutationResults) result.Result[PullRequest] {
+		var payload struct {
+			PullRequest PullRequest `json:"pullRequest"`
+		}
+		err := json.Unmarshal(r.Data[name], &payload)
+		if err != nil {
+			return result.Error[PullRequest](&DecodeError{Body: r.Data[name], Err: err})
+		}
+		return result.Ok(payload.PullRequest)
+	}, response)
+}
@@ -0,0 +1,260 @@
+package github
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// PullRequestDetails holds the information about a single pull request that
+// is too expensive to request for every search result.
+type PullRequestDetails struct {
+	ID          string `json:"id"`
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	Body        string `json:"body"`
+	URL         string `json:"url"`
+	BaseRefName string `json:"baseRefName"`
+	HeadRefName string `json:"headRefName"`
+	UpdatedAt   string `json:"updatedAt"`
+	Labels      struct {
+		Nodes []struct {
+			Name  string `json:"name"`
+			Color string `json:"color"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	Assignees struct {
+		Nodes []struct {
+			Login string `json:"login"`
+		} `json:"nodes"`
+	} `json:"assignees"`
+	ReviewRequests struct {
+		Nodes []struct {
+			RequestedReviewer struct {
+				Login string `json:"login"`
+				Name  string `json:"name"`
+			} `json:"requestedReviewer"`
+		} `json:"nodes"`
+	} `json:"reviewRequests"`
+	LatestReviews struct {
+		Nodes []struct {
+			Author struct {
+				Login string `json:"login"`
+			} `json:"author"`
+			State string `json:"state"`
+		} `json:"nodes"`
+	} `json:"latestReviews"`
+	StatusCheckRollup struct {
+		State    string `json:"state"`
+		Contexts struct {
+			Nodes []CheckContext `json:"nodes"`
+		} `json:"contexts"`
+	} `json:"statusCheckRollup"`
+}
+
+// Reviewer is a user or team asked to review a pull request, or who has
+// reviewed it, along with the state of their latest review. Reviewers that
+// have been requested but have not reviewed yet have the state PENDING.
+type Reviewer struct {
+	Login string
+	State string
+}
+
+// Reviewers returns the requested reviewers and the authors of the latest
+// reviews of the pull request.
+func (d PullRequestDetails) Reviewers() []Reviewer {
+	reviewers := []Reviewer{}
+	for _, review := range d.LatestReviews.Nodes {
+		reviewers = append(reviewers, Reviewer{Login: review.Author.Login, State: review.State})
+	}
+	for _, request := range d.ReviewRequests.Nodes {
+		login := request.RequestedReviewer.Login
+		if login == "" {
+			login = request.RequestedReviewer.Name // teams have no login
+		}
+		reviewed := slices.ContainsFunc(reviewers, func(r Reviewer) bool { return r.Login == login })
+		if !reviewed {
+			reviewers = append(reviewers, Reviewer{Login: login, State: "PENDING"})
+		}
+	}
+	return reviewers
+}
+
+// CheckContext is an entry of a status check rollup. It is either a CheckRun,
+// with a name, status, conclusion and details URL, or a commit StatusContext,
+// with a context, state and target URL.
+type CheckContext struct {
+	Typename   string `json:"__typename"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+	DetailsURL string `json:"detailsUrl"`
+	Context    string `json:"context"`
+	State      string `json:"state"`
+	TargetURL  string `json:"targetUrl"`
+}
+
+// DisplayName returns the name of the check.
+func (c CheckContext) DisplayName() string {
+	if c.Typename == "StatusContext" {
+		return c.Context
+	}
+	return c.Name
+}
+
+// Link returns the URL with the details of the check.
+func (c CheckContext) Link() string {
+	if c.Typename == "StatusContext" {
+		return c.TargetURL
+	}
+	return c.DetailsURL
+}
+
+// Outcome returns the result of the check using the states of a status check
+// rollup: SUCCESS, FAILURE, PENDING, or NEUTRAL for checks that neither passed
+// nor failed such as skipped ones.
+func (c CheckContext) Outcome() string {
+	if c.Typename == "StatusContext" {
+		switch c.State {
+		case "SUCCESS":
+			return "SUCCESS"
+		case "FAILURE", "ERROR":
+			return "FAILURE"
+		default:
+			return "PENDING"
+		}
+	}
+	if c.Status != "COMPLETED" {
+		return "PENDING"
+	}
+	switch c.Conclusion {
+	case "SUCCESS":
+		return "SUCCESS"
+	case "FAILURE", "TIMED_OUT", "CANCELLED", "ACTION_REQUIRED", "STARTUP_FAILURE":
+		return "FAILURE"
+	default:
+		return "NEUTRAL"
+	}
+}
+
+const detailsTemplate = `
+query($id: ID!) {
+  rateLimit {
+    cost
+    remaining
+    resetAt
+  }
+  node(id: $id) {
+    ... on PullRequest {
+      id
+      number
+      title
+      body
+      url
+      baseRefName
+      headRefName
+      updatedAt
+      labels(first: 20) {
+        nodes {
+          name
+          color
+        }
+      }
+      assignees(first: 20) {
+        nodes {
+          login
+        }
+      }
+      reviewRequests(first: 20) {
+        nodes {
+          requestedReviewer {
+            ... on User {
+              login
+            }
+            ... on Team {
+              name
+            }
+          }
+        }
+      }
+      latestReviews(first: 20) {
+        nodes {
+          author {
+            login
+          }
+          state
+        }
+      }
+      statusCheckRollup {
+        state
+        contexts(first: 100) {
+          nodes {
+            __typename
+            ... on CheckRun {
+              name
+              status
+              conclusion
+              detailsUrl
+            }
+            ... on StatusContext {
+              context
+              state
+              targetUrl
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+type detailsResponse struct {
+	Data struct {
+		Node PullRequestDetails `json:"node"`
+	} `json:"data"`
+}
+
+// GetDetails fetches the details of the pull request with the given node ID.
+func GetDetails(ctx context.Context, client Client, id string) result.Result[PullRequestDetails] {
+	response := execute[detailsResponse](ctx, client, Request{
+		Query:     detailsTemplate,
+		Variables: map[string]any{"id": id},
+	})
+	return result.MapNoError(func(r detailsResponse) PullRequestDetails {
+		return r.Data.Node
+	}, response)
+}
+
+// DetailsCache remembers the details of pull requests so that they are only
+// fetched again once the pull request has been updated. It is safe for
+// concurrent use.
+type DetailsCache struct {
+	mu      sync.Mutex
+	details map[string]PullRequestDetails
+}
+
+// NewDetailsCache returns an empty cache.
+func NewDetailsCache() *DetailsCache {
+	return &DetailsCache{details: map[string]PullRequestDetails{}}
+}
+
+// Get returns the details of the given pull request, fetching them through the
+// client unless details as recent as the pull request's updatedAt are cached.
+func (c *DetailsCache) Get(ctx context.Context, client Client, pr PullRequest) result.Result[PullRequestDetails] {
+	c.mu.Lock()
+	cached, present := c.details[pr.ID]
+	c.mu.Unlock()
+	if present && cached.UpdatedAt == pr.UpdatedAt {
+		return result.Ok(cached)
+	}
+	response := GetDetails(ctx, client, pr.ID)
+	if !response.IsError() {
+		c.mu.Lock()
+		c.details[pr.ID] = response.MustGet()
+		c.mu.Unlock()
+	}
+	return response
+}
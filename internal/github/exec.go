@@ -0,0 +1,75 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Ensure that ExecClient implements Client.
+var _ Client = (*ExecClient)(nil)
+
+// ExecClient executes GraphQL requests by running `gh api graphql`.
+type ExecClient struct {
+	host string
+}
+
+// NewExecClient returns a client that runs the gh cli against the given host.
+// An empty host selects the default gh host.
+func NewExecClient(host string) *ExecClient {
+	return &ExecClient{host: host}
+}
+
+// Execute implements Client.
+func (c *ExecClient) Execute(ctx context.Context, request Request) ([]byte, error) {
+	args := []string{"api", "graphql"}
+	if c.host != "" {
+		args = append(args, "--hostname", c.host)
+	}
+	args = append(args, "-f", fmt.Sprintf("query=%s", request.Query))
+	for name, value := range request.Variables {
+		switch value := value.(type) {
+		case nil:
+			continue
+		case string:
+			args = append(args, "-f", fmt.Sprintf("%s=%s", name, value))
+		case []string:
+			for _, item := range value {
+				args = append(args, "-f", fmt.Sprintf("%s[]=%s", name, item))
+			}
+		default:
+			args = append(args, "-F", fmt.Sprintf("%s=%v", name, value))
+		}
+	}
+	cmd := exec.CommandContext(ctx, "gh", args...)
+	cmd.Env = env
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		// gh exits with an error when the response carries GraphQL errors but
+		// still prints the body, so let the caller decode those.
+		if json.Valid(output) {
+			return output, nil
+		}
+		return nil, fmt.Errorf("%s: %w", strings.TrimSpace(stderr.String()), err)
+	}
+	return output, nil
+}
+
+var env []string
+
+func init() {
+	env = os.Environ()
+	for i := range env {
+		if strings.HasPrefix(env[i], "GH_NO_UPDATE_NOTIFIER=") {
+			env[i] = "GH_NO_UPDATE_NOTIFIER=1"
+			return
+		}
+	}
+	env = append(env, "GH_NO_UPDATE_NOTIFIER=1")
+}
@@ -0,0 +1,115 @@
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Ensure that RecordingClient and ReplayClient implement Client.
+var (
+	_ Client = (*RecordingClient)(nil)
+	_ Client = (*ReplayClient)(nil)
+)
+
+// fixture is the on disk form of a recorded request and its response.
+type fixture struct {
+	Request  Request         `json:"request"`
+	Response json.RawMessage `json:"response"`
+}
+
+// RecordingClient passes requests through to another client and saves each
+// request and its response to a directory so that they can be served later by
+// a ReplayClient.
+type RecordingClient struct {
+	client Client
+	dir    string
+}
+
+// NewRecordingClient returns a client that records the traffic of the given
+// client into dir. The directory is created if it does not exist.
+func NewRecordingClient(client Client, dir string) (*RecordingClient, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	return &RecordingClient{client: client, dir: dir}, nil
+}
+
+// Execute implements Client.
+func (c *RecordingClient) Execute(ctx context.Context, request Request) ([]byte, error) {
+	body, err := c.client.Execute(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	path, err := fixturePath(c.dir, request)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(fixture{Request: request, Response: body}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	err = os.WriteFile(path, data, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// ReplayClient serves responses previously saved by a RecordingClient without
+// contacting GitHub.
+type ReplayClient struct {
+	dir string
+}
+
+// NewReplayClient returns a client that serves the recordings in dir.
+func NewReplayClient(dir string) (*ReplayClient, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	return &ReplayClient{dir: dir}, nil
+}
+
+// Execute implements Client.
+func (c *ReplayClient) Execute(_ context.Context, request Request) ([]byte, error) {
+	path, err := fixturePath(c.dir, request)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("no recorded response in %s for this query", c.dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var recorded fixture
+	err = json.Unmarshal(data, &recorded)
+	if err != nil {
+		return nil, &DecodeError{Body: data, Err: err}
+	}
+	return recorded.Response, nil
+}
+
+// fixturePath returns the file in dir that holds the recording for the given
+// request. The name is derived from a hash of the request so that identical
+// queries map to the same file.
+func fixturePath(dir string, request Request) (string, error) {
+	key, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(key)
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json"), nil
+}
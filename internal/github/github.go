@@ -2,11 +2,8 @@ package github
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"os"
-	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/sassoftware/sas-ggdk/pkg/result"
 	"github.com/sassoftware/sas-ggdk/pkg/sliceutils"
@@ -14,39 +11,101 @@ import (
 
 type PullRequestSearchResults struct {
 	Data struct {
-		Search struct {
-			IssueCount int `json:"issueCount"`
+		RateLimit RateLimit `json:"rateLimit"`
+		Search    struct {
+			IssueCount int      `json:"issueCount"`
+			PageInfo   PageInfo `json:"pageInfo"`
 			Edges      []struct {
-				Node struct {
-					Additions int `json:"additions"`
-					Author    struct {
-						Login string `json:"login"`
-					} `json:"author"`
-					ChangedFiles int    `json:"changedFiles"`
-					CreatedAt    string `json:"string"`
-					Deletions    int    `json:"deletions"`
-					Number       int    `json:"number"`
-					Repository   struct {
-						NameWithOwner string `json:"nameWithOwner"`
-					} `json:"repository"`
-					ReviewDecision    string `json:"reviewDecision"`
-					StatusCheckRollup struct {
-						State string `json:"state"`
-					} `json:"statusCheckRollup"`
-					Title              string `json:"title"`
-					URL                string `json:"url"`
-					Mergeable          string `json:"mergeable"`
-					MergeStateStatus   string `json:"mergeStateStatus"`
-					IsDraft            bool   `json:"isDraft"`
-					State              string `json:"state"`
-					UpdatedAt          string `json:"updatedAt"`
-					TotalCommentsCount int    `json:"totalCommentsCount"`
-				} `json:"node"`
+				Node PullRequest `json:"node"`
 			} `json:"edges"`
 		} `json:"search"`
 	} `json:"data"`
 }
 
+// PullRequest holds the fields of a pull request returned by a search. Host is
+// not part of the response and is filled in with the host that was queried.
+type PullRequest struct {
+	Host      string `json:"host,omitempty"`
+	ID        string `json:"id"`
+	Additions int    `json:"additions"`
+	Author    struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	ChangedFiles int    `json:"changedFiles"`
+	CreatedAt    string `json:"createdAt"`
+	Deletions    int    `json:"deletions"`
+	Number       int    `json:"number"`
+	Repository   struct {
+		NameWithOwner      string `json:"nameWithOwner"`
+		MergeCommitAllowed bool   `json:"mergeCommitAllowed"`
+		SquashMergeAllowed bool   `json:"squashMergeAllowed"`
+		RebaseMergeAllowed bool   `json:"rebaseMergeAllowed"`
+	} `json:"repository"`
+	ReviewDecision    string `json:"reviewDecision"`
+	StatusCheckRollup struct {
+		State    string `json:"state"`
+		Contexts struct {
+			Nodes []CheckContext `json:"nodes"`
+		} `json:"contexts"`
+	} `json:"statusCheckRollup"`
+	Title              string `json:"title"`
+	URL                string `json:"url"`
+	Mergeable          string `json:"mergeable"`
+	MergeStateStatus   string `json:"mergeStateStatus"`
+	IsDraft            bool   `json:"isDraft"`
+	State              string `json:"state"`
+	UpdatedAt          string `json:"updatedAt"`
+	TotalCommentsCount int    `json:"totalCommentsCount"`
+	HeadRefOid         string `json:"headRefOid"`
+	AutoMergeRequest   *struct {
+		MergeMethod MergeMethod `json:"mergeMethod"`
+	} `json:"autoMergeRequest"`
+}
+
+// FailingChecks returns the checks of the pull request that failed.
+func (pr PullRequest) FailingChecks() []CheckContext {
+	failing := []CheckContext{}
+	for _, check := range pr.StatusCheckRollup.Contexts.Nodes {
+		if check.Outcome() == "FAILURE" {
+			failing = append(failing, check)
+		}
+	}
+	return failing
+}
+
+// PageInfo holds the cursor information for a page of search results.
+type PageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// RateLimit holds the GraphQL rate limit status reported with a response. Cost
+// is the number of points spent to produce the response and Remaining is the
+// number of points left until the budget is replenished at ResetAt.
+type RateLimit struct {
+	Cost      int       `json:"cost"`
+	Remaining int       `json:"remaining"`
+	ResetAt   time.Time `json:"resetAt"`
+}
+
+// Merge combines the rate limit status of two responses. Costs are added and
+// the lowest remaining budget is kept.
+func (r RateLimit) Merge(other RateLimit) RateLimit {
+	if other.ResetAt.IsZero() {
+		return r
+	}
+	if r.ResetAt.IsZero() {
+		return other
+	}
+	merged := other
+	merged.Cost = r.Cost + other.Cost
+	if r.Remaining < other.Remaining {
+		merged.Remaining = r.Remaining
+		merged.ResetAt = r.ResetAt
+	}
+	return merged
+}
+
 // SearchResults is a sealed interface that is used to indicate which structs
 // are returned as search results from this github client
 type SearchResults interface {
@@ -71,42 +130,91 @@ type AllPRs struct {
 
 func (*AllPRs) isSearchResults() {}
 
-const template = `
-{
-  search(query: "%s", type: ISSUE, first: 100) {
-    issueCount edges {
-	  node {
-	    ... on PullRequest {
-		  statusCheckRollup {
-		    state
-          }
-		  number
-		  title
-		  repository {
-		    nameWithOwner
-		  }
-		  createdAt
-		  url
-		  changedFiles
-		  additions
-		  deletions
-		  reviewDecision
-		  author {
-		    login
-		  }
-		  mergeable
-		  mergeStateStatus
-		  isDraft
-		  state
-		  updatedAt
-		  totalCommentsCount
+// PageSize is the largest number of results the search API returns per
+// request.
+const PageSize = 100
+
+// DefaultLimit is the overall number of results loaded when no limit is
+// configured.
+const DefaultLimit = 1000
+
+// pullRequestFragment selects the fields of a PullRequest. It is shared by
+// every query and mutation returning pull requests so that they can all be
+// shown in the same table.
+const pullRequestFragment = `
+fragment PullRequestFields on PullRequest {
+  statusCheckRollup {
+    state
+    contexts(first: 100) {
+      nodes {
+        __typename
+        ... on CheckRun {
+          name
+          status
+          conclusion
+          detailsUrl
+        }
+        ... on StatusContext {
+          context
+          state
+          targetUrl
         }
       }
     }
   }
+  id
+  number
+  title
+  repository {
+    nameWithOwner
+    mergeCommitAllowed
+    squashMergeAllowed
+    rebaseMergeAllowed
+  }
+  createdAt
+  url
+  changedFiles
+  additions
+  deletions
+  reviewDecision
+  author {
+    login
+  }
+  mergeable
+  mergeStateStatus
+  isDraft
+  state
+  updatedAt
+  totalCommentsCount
+  headRefOid
+  autoMergeRequest {
+    mergeMethod
+  }
 }
 `
 
+const template = `
+query($searchQuery: String!, $first: Int!, $after: String) {
+  rateLimit {
+    cost
+    remaining
+    resetAt
+  }
+  search(query: $searchQuery, type: ISSUE, first: $first, after: $after) {
+    issueCount
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    edges {
+      node {
+        ...PullRequestFields
+      }
+    }
+  }
+}
+` + pullRequestFragment
+
 type Option func(string) string
 
 func WithDrafts(include bool) func(string) string {
@@ -151,35 +259,61 @@ func ForMyRequests(query string) string {
 	return query + "is:pr review-requested:@me"
 }
 
-func ExecuteQuery(ctx context.Context, options ...Option) result.Result[PullRequestSearchResults] {
+// ForQuery adds a raw GitHub search query fragment, such as
+// "team-review-requested:org/team", restricted to pull requests.
+func ForQuery(fragment string) func(string) string {
+	return func(query string) string {
+		if len(query) != 0 {
+			query = query + " "
+		}
+		return query + "is:pr " + fragment
+	}
+}
+
+// ExecuteQuery runs the search built from the given options through the
+// client and follows the result cursor until all results, or limit results,
+// have been loaded. A limit of zero or less uses DefaultLimit.
+func ExecuteQuery(ctx context.Context, client Client, limit int, options ...Option) result.Result[PullRequestSearchResults] {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
 	query := ""
 	for _, option := range options {
 		query = option(query)
 	}
-	query = fmt.Sprintf(template, query)
-	cmd := exec.CommandContext(ctx, "gh", "api", "graphql", "-f", fmt.Sprintf("query=%s", query))
-	cmd.Env = env
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return result.Error[PullRequestSearchResults](fmt.Errorf("%s: %w", output, err))
-	}
-	var response PullRequestSearchResults
-	err = json.Unmarshal(output, &response)
-	if err != nil {
-		return result.Error[PullRequestSearchResults](err)
+	var results PullRequestSearchResults
+	after := ""
+	for {
+		first := min(PageSize, limit-len(results.Data.Search.Edges))
+		response := executePage(ctx, client, query, first, after)
+		if response.IsError() {
+			return response
+		}
+		page := response.MustGet()
+		results.Data.RateLimit = results.Data.RateLimit.Merge(page.Data.RateLimit)
+		results.Data.Search.IssueCount = page.Data.Search.IssueCount
+		results.Data.Search.PageInfo = page.Data.Search.PageInfo
+		results.Data.Search.Edges = append(results.Data.Search.Edges, page.Data.Search.Edges...)
+		if !page.Data.Search.PageInfo.HasNextPage || len(results.Data.Search.Edges) >= limit {
+			break
+		}
+		after = page.Data.Search.PageInfo.EndCursor
 	}
-	return result.Ok(response)
+	return result.Ok(results)
 }
 
-var env []string
-
-func init() {
-	env = os.Environ()
-	for i := range env {
-		if strings.HasPrefix(env[i], "GH_NO_UPDATE_NOTIFIER=") {
-			env[i] = "GH_NO_UPDATE_NOTIFIER=1"
-			return
-		}
+// executePage fetches a single page of search results starting after the
+// given cursor.
+func executePage(ctx context.Context, client Client, query string, first int, after string) result.Result[PullRequestSearchResults] {
+	variables := map[string]any{
+		"searchQuery": query,
+		"first":       first,
+	}
+	if after != "" {
+		variables["after"] = after
 	}
-	env = append(env, "GH_NO_UPDATE_NOTIFIER=1")
+	return execute[PullRequestSearchResults](ctx, client, Request{
+		Query:     template,
+		Variables: variables,
+	})
 }
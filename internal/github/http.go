@@ -0,0 +1,97 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	gh "github.com/cli/go-gh"
+	"github.com/cli/go-gh/pkg/api"
+	"github.com/cli/go-gh/pkg/auth"
+)
+
+// Ensure that HTTPClient implements Client.
+var _ Client = (*HTTPClient)(nil)
+
+// DefaultHost returns the host the gh cli is configured to use by default.
+func DefaultHost() string {
+	host, _ := auth.DefaultHost()
+	return host
+}
+
+// HTTPClient talks to the GraphQL endpoint of a GitHub host directly using the
+// token and host configuration of the gh cli.
+type HTTPClient struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewHTTPClient returns a client for the given host. An empty host selects the
+// default gh host. An error is returned if no token is configured for the
+// host.
+func NewHTTPClient(host string) (*HTTPClient, error) {
+	if host == "" {
+		host = DefaultHost()
+	}
+	token, _ := auth.TokenForHost(host)
+	if token == "" {
+		return nil, fmt.Errorf("no authentication token found for %s", host)
+	}
+	client, err := gh.HTTPClient(&api.ClientOptions{
+		Host:      host,
+		AuthToken: token,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &HTTPClient{
+		endpoint: graphQLEndpoint(host),
+		client:   client,
+	}, nil
+}
+
+// Execute implements Client.
+func (c *HTTPClient) Execute(ctx context.Context, request Request) ([]byte, error) {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       body,
+		}
+	}
+	return body, nil
+}
+
+// graphQLEndpoint returns the GraphQL API URL for the given host.
+func graphQLEndpoint(host string) string {
+	host = strings.ToLower(host)
+	if host == "github.com" || host == "api.github.com" {
+		return "https://api.github.com/graphql"
+	}
+	if strings.HasSuffix(host, ".ghe.com") {
+		return fmt.Sprintf("https://api.%s/graphql", host)
+	}
+	return fmt.Sprintf("https://%s/api/graphql", host)
+}
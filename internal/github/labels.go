@@ -0,0 +1,94 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// LabelResults holds the label looked up by name in a repository.
+type LabelResults struct {
+	Data struct {
+		Repository struct {
+			Label *struct {
+				ID string `json:"id"`
+			} `json:"label"`
+		} `json:"repository"`
+	} `json:"data"`
+}
+
+const labelTemplate = `
+query($owner: String!, $name: String!, $label: String!) {
+  rateLimit {
+    cost
+    remaining
+    resetAt
+  }
+  repository(owner: $owner, name: $name) {
+    label(name: $label) {
+      id
+    }
+  }
+}
+`
+
+// labelID returns the node ID of the named label of the given repository.
+func labelID(ctx context.Context, client Client, nameWithOwner string, label string) result.Result[string] {
+	owner, name, _ := strings.Cut(nameWithOwner, "/")
+	response := execute[LabelResults](ctx, client, Request{
+		Query: labelTemplate,
+		Variables: map[string]any{
+			"owner": owner,
+			"name":  name,
+			"label": label,
+		},
+	})
+	return result.FlatMap(func(r LabelResults) result.Result[string] {
+		if r.Data.Repository.Label == nil {
+			return result.Error[string](fmt.Errorf("label %q not found in %s", label, nameWithOwner))
+		}
+		return result.Ok(r.Data.Repository.Label.ID)
+	}, response)
+}
+
+const addLabelTemplate = `
+mutation($id: ID!, $labelId: ID!) {
+  addLabelsToLabelable(input: {labelableId: $id, labelIds: [$labelId]}) {
+    pullRequest: labelable {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// AddLabel adds the named label of its repository to the given pull request.
+func AddLabel(ctx context.Context, client Client, pr PullRequest, label string) result.Result[PullRequest] {
+	return result.FlatMap(func(id string) result.Result[PullRequest] {
+		return mutate(ctx, client, "addLabelsToLabelable", addLabelTemplate, map[string]any{
+			"id":      pr.ID,
+			"labelId": id,
+		})
+	}, labelID(ctx, client, pr.Repository.NameWithOwner, label))
+}
+
+const removeLabelTemplate = `
+mutation($id: ID!, $labelId: ID!) {
+  removeLabelsFromLabelable(input: {labelableId: $id, labelIds: [$labelId]}) {
+    pullRequest: labelable {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// RemoveLabel removes the named label from the given pull request.
+func RemoveLabel(ctx context.Context, client Client, pr PullRequest, label string) result.Result[PullRequest] {
+	return result.FlatMap(func(id string) result.Result[PullRequest] {
+		return mutate(ctx, client, "removeLabelsFromLabelable", removeLabelTemplate, map[string]any{
+			"id":      pr.ID,
+			"labelId": id,
+		})
+	}, labelID(ctx, client, pr.Repository.NameWithOwner, label))
+}
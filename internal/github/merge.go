@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// MergeMethod is the way the commits of a pull request are added to its base
+// branch.
+type MergeMethod string
+
+// Possible merge methods.
+const (
+	Merge  MergeMethod = "MERGE"
+	Squash MergeMethod = "SQUASH"
+	Rebase MergeMethod = "REBASE"
+)
+
+// MergeMethods returns the merge methods allowed by the repository of the pull
+// request.
+func (pr PullRequest) MergeMethods() []MergeMethod {
+	methods := []MergeMethod{}
+	if pr.Repository.MergeCommitAllowed {
+		methods = append(methods, Merge)
+	}
+	if pr.Repository.SquashMergeAllowed {
+		methods = append(methods, Squash)
+	}
+	if pr.Repository.RebaseMergeAllowed {
+		methods = append(methods, Rebase)
+	}
+	return methods
+}
+
+// IsBehind returns true when the head branch of the pull request is behind its
+// base branch.
+func (pr PullRequest) IsBehind() bool {
+	return pr.MergeStateStatus == "BEHIND"
+}
+
+const mergeTemplate = `
+mutation($id: ID!, $method: PullRequestMergeMethod!) {
+  mergePullRequest(input: {pullRequestId: $id, mergeMethod: $method}) {
+    pullRequest {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// MergePullRequest merges the pull request with the given node ID using the
+// given method and returns the merged pull request.
+func MergePullRequest(ctx context.Context, client Client, id string, method MergeMethod) result.Result[PullRequest] {
+	return mutate(ctx, client, "mergePullRequest", mergeTemplate, map[string]any{
+		"id":     id,
+		"method": string(method),
+	})
+}
+
+const enableAutoMergeTemplate = `
+mutation($id: ID!, $method: PullRequestMergeMethod!) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $method}) {
+    pullRequest {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// EnableAutoMerge merges the pull request with the given node ID using the
+// given method as soon as its requirements are met.
+func EnableAutoMerge(ctx context.Context, client Client, id string, method MergeMethod) result.Result[PullRequest] {
+	return mutate(ctx, client, "enablePullRequestAutoMerge", enableAutoMergeTemplate, map[string]any{
+		"id":     id,
+		"method": string(method),
+	})
+}
+
+const disableAutoMergeTemplate = `
+mutation($id: ID!) {
+  disablePullRequestAutoMerge(input: {pullRequestId: $id}) {
+    pullRequest {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// DisableAutoMerge cancels the automatic merge of the pull request with the
+// given node ID.
+func DisableAutoMerge(ctx context.Context, client Client, id string) result.Result[PullRequest] {
+	return mutate(ctx, client, "disablePullRequestAutoMerge", disableAutoMergeTemplate, map[string]any{
+		"id": id,
+	})
+}
+
+const updateBranchTemplate = `
+mutation($id: ID!) {
+  updatePullRequestBranch(input: {pullRequestId: $id}) {
+    pullRequest {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// UpdateBranch merges the base branch into the head branch of the pull request
+// with the given node ID.
+func UpdateBranch(ctx context.Context, client Client, id string) result.Result[PullRequest] {
+	return mutate(ctx, client, "updatePullRequestBranch", updateBranchTemplate, map[string]any{
+		"id": id,
+	})
+}
@@ -0,0 +1,53 @@
+package github
+
+import (
+	"context"
+
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// NodesResults holds the pull requests looked up by node ID. Nodes that no
+// longer exist, or are not pull requests, are nil.
+type NodesResults struct {
+	Data struct {
+		RateLimit RateLimit      `json:"rateLimit"`
+		Nodes     []*PullRequest `json:"nodes"`
+	} `json:"data"`
+}
+
+const nodesTemplate = `
+query($ids: [ID!]!) {
+  rateLimit {
+    cost
+    remaining
+    resetAt
+  }
+  nodes(ids: $ids) {
+    ...PullRequestFields
+  }
+}
+` + pullRequestFragment
+
+// GetPullRequests fetches the pull requests with the given node IDs. IDs that
+// do not identify a pull request are skipped. At most PageSize IDs are looked
+// up per request.
+func GetPullRequests(ctx context.Context, client Client, ids []string) result.Result[[]PullRequest] {
+	prs := []PullRequest{}
+	for start := 0; start < len(ids); start += PageSize {
+		response := execute[NodesResults](ctx, client, Request{
+			Query: nodesTemplate,
+			Variables: map[string]any{
+				"ids": ids[start:min(start+PageSize, len(ids))],
+			},
+		})
+		if response.IsError() {
+			return result.Error[[]PullRequest](response.Error())
+		}
+		for _, node := range response.MustGet().Data.Nodes {
+			if node != nil && node.ID != "" {
+				prs = append(prs, *node)
+			}
+		}
+	}
+	return result.Ok(prs)
+}
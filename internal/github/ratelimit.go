@@ -0,0 +1,72 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// RateLimitTracker keeps the rate limit status reported by every response of
+// the clients it wraps, merged with RateLimit.Merge until the budget is
+// replenished. Mutations cannot select the rate limit so their cost is only
+// reflected by the remaining budget of the next query. It is safe for
+// concurrent use.
+type RateLimitTracker struct {
+	mu        sync.Mutex
+	rateLimit RateLimit
+}
+
+// NewRateLimitTracker returns a tracker that has not seen any responses.
+func NewRateLimitTracker() *RateLimitTracker {
+	return &RateLimitTracker{}
+}
+
+// Client returns a client that executes requests with the given client and
+// records the rate limit status of each response.
+func (t *RateLimitTracker) Client(client Client) Client {
+	return &trackingClient{client: client, tracker: t}
+}
+
+// Record merges the given rate limit status into the tracked one. Once the
+// tracked budget has been replenished the given status replaces it.
+func (t *RateLimitTracker) Record(rateLimit RateLimit) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.rateLimit.ResetAt.IsZero() && time.Now().After(t.rateLimit.ResetAt) {
+		t.rateLimit = RateLimit{}
+	}
+	t.rateLimit = t.rateLimit.Merge(rateLimit)
+}
+
+// RateLimit returns the tracked rate limit status. ResetAt is zero until a
+// response reported one.
+func (t *RateLimitTracker) RateLimit() RateLimit {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rateLimit
+}
+
+// Ensure that trackingClient implements Client.
+var _ Client = (*trackingClient)(nil)
+
+type trackingClient struct {
+	client  Client
+	tracker *RateLimitTracker
+}
+
+func (c *trackingClient) Execute(ctx context.Context, request Request) ([]byte, error) {
+	body, err := c.client.Execute(ctx, request)
+	if err != nil {
+		return body, err
+	}
+	var response struct {
+		Data struct {
+			RateLimit RateLimit `json:"rateLimit"`
+		} `json:"data"`
+	}
+	if json.Unmarshal(body, &response) == nil {
+		c.tracker.Record(response.Data.RateLimit)
+	}
+	return body, nil
+}
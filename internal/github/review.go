@@ -0,0 +1,57 @@
+package github
+
+import (
+	"context"
+
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// ReviewEvent is the action taken by a pull request review.
+type ReviewEvent string
+
+// Possible review events.
+const (
+	Approve        ReviewEvent = "APPROVE"
+	RequestChanges ReviewEvent = "REQUEST_CHANGES"
+	Comment        ReviewEvent = "COMMENT"
+)
+
+// AddReviewResults holds the pull request as it is after a review was added.
+type AddReviewResults struct {
+	Data struct {
+		AddPullRequestReview struct {
+			PullRequestReview struct {
+				PullRequest PullRequest `json:"pullRequest"`
+			} `json:"pullRequestReview"`
+		} `json:"addPullRequestReview"`
+	} `json:"data"`
+}
+
+const addReviewTemplate = `
+mutation($id: ID!, $event: PullRequestReviewEvent!, $body: String) {
+  addPullRequestReview(input: {pullRequestId: $id, event: $event, body: $body}) {
+    pullRequestReview {
+      pullRequest {
+        ...PullRequestFields
+      }
+    }
+  }
+}
+` + pullRequestFragment
+
+// AddReview submits a review with the given event and body on the pull request
+// with the given node ID and returns the updated pull request. Request changes
+// and comment reviews require a body.
+func AddReview(ctx context.Context, client Client, id string, event ReviewEvent, body string) result.Result[PullRequest] {
+	response := execute[AddReviewResults](ctx, client, Request{
+		Query: addReviewTemplate,
+		Variables: map[string]any{
+			"id":    id,
+			"event": string(event),
+			"body":  body,
+		},
+	})
+	return result.MapNoError(func(r AddReviewResults) PullRequest {
+		return r.Data.AddPullRequestReview.PullRequestReview.PullRequest
+	}, response)
+}
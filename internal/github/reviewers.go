@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// ReviewerResults holds the user or team looked up as a reviewer.
+type ReviewerResults struct {
+	Data struct {
+		User *struct {
+			ID string `json:"id"`
+		} `json:"user"`
+		Organization *struct {
+			Team *struct {
+				ID string `json:"id"`
+			} `json:"team"`
+		} `json:"organization"`
+	} `json:"data"`
+}
+
+const userTemplate = `
+query($login: String!) {
+  rateLimit {
+    cost
+    remaining
+    resetAt
+  }
+  user(login: $login) {
+    id
+  }
+}
+`
+
+const teamTemplate = `
+query($org: String!, $slug: String!) {
+  rateLimit {
+    cost
+    remaining
+    resetAt
+  }
+  organization(login: $org) {
+    team(slug: $slug) {
+      id
+    }
+  }
+}
+`
+
+// reviewerID returns the node ID of the given reviewer, which is either a user
+// login or a team as "org/team-slug", along with whether it is a team.
+func reviewerID(ctx context.Context, client Client, reviewer string) (result.Result[string], bool) {
+	org, slug, isTeam := strings.Cut(reviewer, "/")
+	request := Request{
+		Query:     userTemplate,
+		Variables: map[string]any{"login": reviewer},
+	}
+	if isTeam {
+		request = Request{
+			Query:     teamTemplate,
+			Variables: map[string]any{"org": org, "slug": slug},
+		}
+	}
+	response := execute[ReviewerResults](ctx, client, request)
+	return result.FlatMap(func(r ReviewerResults) result.Result[string] {
+		switch {
+		case isTeam && r.Data.Organization != nil && r.Data.Organization.Team != nil:
+			return result.Ok(r.Data.Organization.Team.ID)
+		case !isTeam && r.Data.User != nil:
+			return result.Ok(r.Data.User.ID)
+		}
+		return result.Error[string](fmt.Errorf("reviewer %q not found", reviewer))
+	}, response), isTeam
+}
+
+const requestUserReviewTemplate = `
+mutation($id: ID!, $reviewerId: ID!) {
+  requestReviews(input: {pullRequestId: $id, userIds: [$reviewerId], union: true}) {
+    pullRequest {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+const requestTeamReviewTemplate = `
+mutation($id: ID!, $reviewerId: ID!) {
+  requestReviews(input: {pullRequestId: $id, teamIds: [$reviewerId], union: true}) {
+    pullRequest {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// RequestReviewer asks the given user, or team as "org/team-slug", to review
+// the pull request with the given node ID. Reviewers already requested are
+// kept.
+func RequestReviewer(ctx context.Context, client Client, id string, reviewer string) result.Result[PullRequest] {
+	reviewerIDResult, isTeam := reviewerID(ctx, client, reviewer)
+	template := requestUserReviewTemplate
+	if isTeam {
+		template = requestTeamReviewTemplate
+	}
+	return result.FlatMap(func(reviewerID string) result.Result[PullRequest] {
+		return mutate(ctx, client, "requestReviews", template, map[string]any{
+			"id":         id,
+			"reviewerId": reviewerID,
+		})
+	}, reviewerIDResult)
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+const closeTemplate = `
+mutation($id: ID!) {
+  closePullRequest(input: {pullRequestId: $id}) {
+    pullRequest {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// ClosePullRequest closes the pull request with the given node ID without
+// merging it.
+func ClosePullRequest(ctx context.Context, client Client, id string) result.Result[PullRequest] {
+	return mutate(ctx, client, "closePullRequest", closeTemplate, map[string]any{
+		"id": id,
+	})
+}
+
+const convertToDraftTemplate = `
+mutation($id: ID!) {
+  convertPullRequestToDraft(input: {pullRequestId: $id}) {
+    pullRequest {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// ConvertToDraft turns the pull request with the given node ID into a draft.
+func ConvertToDraft(ctx context.Context, client Client, id string) result.Result[PullRequest] {
+	return mutate(ctx, client, "convertPullRequestToDraft", convertToDraftTemplate, map[string]any{
+		"id": id,
+	})
+}
+
+const markReadyTemplate = `
+mutation($id: ID!) {
+  markPullRequestReadyForReview(input: {pullRequestId: $id}) {
+    pullRequest {
+      ...PullRequestFields
+    }
+  }
+}
+`
+
+// MarkReady marks the draft pull request with the given node ID as ready for
+// review.
+func MarkReady(ctx context.Context, client Client, id string) result.Result[PullRequest] {
+	return mutate(ctx, client, "markPullRequestReadyForReview", markReadyTemplate, map[string]any{
+		"id": id,
+	})
+}
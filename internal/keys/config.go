@@ -0,0 +1,159 @@
+package keys
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// actions names each key binding for the keys section of the configuration.
+func (k *KeyMap) actions() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"quit":            &k.Quit,
+		"help":            &k.Help,
+		"open":            &k.Open,
+		"nextTab":         &k.NextTab,
+		"prevTab":         &k.PrevTab,
+		"lineUp":          &k.LineUp,
+		"lineDown":        &k.LineDown,
+		"pageUp":          &k.PageUp,
+		"pageDown":        &k.PageDown,
+		"gotoTop":         &k.GotoTop,
+		"gotoBottom":      &k.GotoBottom,
+		"reload":          &k.Reload,
+		"toggleDrafts":    &k.ToggleDrafts,
+		"toggleClosed":    &k.ToggleClosed,
+		"individualQuery": &k.IndividualQuery,
+		"wideView":        &k.WideView,
+		"sort":            &k.Sort,
+		"flipSort":        &k.FlipSort,
+		"groupBy":         &k.GroupBy,
+		"collapse":        &k.Collapse,
+		"collapseAll":     &k.CollapseAll,
+		"details":         &k.Details,
+		"checks":          &k.Checks,
+		"filter":          &k.Filter,
+		"showSnoozed":     &k.ShowSnoozed,
+		"approve":         &k.Approve,
+		"requestChanges":  &k.RequestChanges,
+		"comment":         &k.Comment,
+		"merge":           &k.Merge,
+		"autoMerge":       &k.AutoMerge,
+		"updateBranch":    &k.UpdateBranch,
+		"mark":            &k.Mark,
+		"markAll":         &k.MarkAll,
+		"markMatching":    &k.MarkMatching,
+		"bulk":            &k.Bulk,
+		"snooze":          &k.Snooze,
+		"pin":             &k.Pin,
+		"openCheck":       &k.OpenCheck,
+		"closeChecks":     &k.CloseChecks,
+		"applyFilter":     &k.ApplyFilter,
+		"clearFilter":     &k.ClearFilter,
+		"submitReview":    &k.SubmitReview,
+		"cancelReview":    &k.CancelReview,
+		"submit":          &k.Submit,
+		"cancel":          &k.Cancel,
+		"closeBulk":       &k.CloseBulk,
+		"closeHelp":       &k.CloseHelp,
+	}
+}
+
+// scopes lists the actions whose keys are handled together and so must not
+// share a key, by the name of the view they apply to.
+var scopes = map[string][]string{
+	"PR list": {
+		"quit", "help", "open", "nextTab", "prevTab",
+		"lineUp", "lineDown", "pageUp", "pageDown", "gotoTop", "gotoBottom",
+		"reload", "toggleDrafts", "toggleClosed", "individualQuery",
+		"wideView", "sort", "flipSort", "groupBy", "collapse", "collapseAll", "details", "checks", "filter", "showSnoozed",
+		"approve", "requestChanges", "comment", "merge", "autoMerge", "updateBranch",
+		"mark", "markAll", "markMatching", "bulk", "snooze", "pin",
+	},
+	"checks view": {
+		"lineUp", "lineDown", "pageUp", "pageDown", "gotoTop", "gotoBottom",
+		"openCheck", "closeChecks",
+	},
+	"filter bar":  {"applyFilter", "clearFilter"},
+	"review form": {"submitReview", "cancelReview"},
+	"prompts":     {"submit", "cancel"},
+}
+
+// choiceKeys are the fixed keys answering confirmations, such as "y" for yes
+// or "s" for a squash merge. The cancel key is handled along with them.
+var choiceKeys = []string{"y", "u", "d", "c", "l", "L", "v", "r", "a", "m", "s"}
+
+// textActions are the actions handled while text is typed into the filter
+// bar, the review form or a prompt, where printable keys are taken as text.
+var textActions = []string{"applyFilter", "clearFilter", "submitReview", "cancelReview", "submit", "cancel"}
+
+// printable reports whether the given key types a character.
+func printable(keyName string) bool {
+	r, size := utf8.DecodeRuneInString(keyName)
+	return size == len(keyName) && r != utf8.RuneError && unicode.IsPrint(r)
+}
+
+// helpKeys names the given keys for the help, spelling out the space bar.
+func helpKeys(keyNames []string) string {
+	names := make([]string, 0, len(keyNames))
+	for _, keyName := range keyNames {
+		if keyName == " " {
+			keyName = "space"
+		}
+		names = append(names, keyName)
+	}
+	return strings.Join(names, "/")
+}
+
+// New returns the built in key bindings with the keys of the given actions
+// replaced. Actions are named as in the keys section of the configuration,
+// for example "quit" or "lineDown". An error is returned for unknown actions,
+// actions without keys, keys bound to several actions of the same view, a
+// cancel key answering a confirmation and printable keys for the actions of
+// text inputs.
+func New(overrides map[string][]string) (KeyMap, error) {
+	k := Default()
+	actions := k.actions()
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		binding, present := actions[name]
+		if !present {
+			return k, fmt.Errorf("keys: unknown action: %s (must be one of %s)", name, strings.Join(slices.Sorted(maps.Keys(actions)), ", "))
+		}
+		keyNames := overrides[name]
+		if len(keyNames) == 0 || slices.Contains(keyNames, "") {
+			return k, fmt.Errorf("keys: %s: keys must not be empty", name)
+		}
+		binding.SetKeys(keyNames...)
+		binding.SetHelp(helpKeys(keyNames), binding.Help().Desc)
+	}
+	for _, scope := range slices.Sorted(maps.Keys(scopes)) {
+		bound := map[string]string{}
+		for _, name := range scopes[scope] {
+			for _, keyName := range actions[name].Keys() {
+				other, present := bound[keyName]
+				if present && other != name {
+					return k, fmt.Errorf("keys: %q is bound to both %s and %s in the %s", keyName, other, name, scope)
+				}
+				bound[keyName] = name
+			}
+		}
+	}
+	for _, keyName := range k.Cancel.Keys() {
+		if slices.Contains(choiceKeys, keyName) {
+			return k, fmt.Errorf("keys: %q is bound to cancel but answers confirmations", keyName)
+		}
+	}
+	for _, name := range textActions {
+		for _, keyName := range actions[name].Keys() {
+			if printable(keyName) {
+				return k, fmt.Errorf("keys: %s: %q types text and cannot be used while typing", name, keyName)
+			}
+		}
+	}
+	return k, nil
+}
@@ -0,0 +1,46 @@
+package keys
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string][]string
+		err       string
+	}{
+		{name: "defaults"},
+		{name: "remapped", overrides: map[string][]string{"reload": {"R"}, "requestChanges": {"r"}}},
+		{name: "same key in different views", overrides: map[string][]string{"openCheck": {"p"}}},
+		{name: "unknown action", overrides: map[string][]string{"explode": {"x"}}, err: "unknown action: explode"},
+		{name: "no keys", overrides: map[string][]string{"quit": {}}, err: "quit: keys must not be empty"},
+		{name: "empty key", overrides: map[string][]string{"quit": {""}}, err: "quit: keys must not be empty"},
+		{name: "conflict", overrides: map[string][]string{"sort": {"a"}}, err: `"a" is bound to both`},
+		{name: "conflict in checks view", overrides: map[string][]string{"openCheck": {"k"}}, err: "in the checks view"},
+		{name: "cancel answers confirmations", overrides: map[string][]string{"cancel": {"y"}}, err: `"y" is bound to cancel`},
+		{name: "printable filter key", overrides: map[string][]string{"applyFilter": {"f"}}, err: `applyFilter: "f" types text`},
+		{name: "printable review key", overrides: map[string][]string{"submitReview": {" "}}, err: `submitReview: " " types text`},
+		{name: "control review key", overrides: map[string][]string{"submitReview": {"ctrl+d"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := New(tt.overrides)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("New() error = %v", err)
+				}
+				for name, keyNames := range tt.overrides {
+					if got := k.actions()[name].Keys(); strings.Join(got, ",") != strings.Join(keyNames, ",") {
+						t.Errorf("%s keys = %v, want %v", name, got, keyNames)
+					}
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("New() error = %v, want one containing %q", err, tt.err)
+			}
+		})
+	}
+}
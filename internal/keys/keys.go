@@ -0,0 +1,190 @@
+// Package keys defines the key bindings of the user interface along with the
+// help shown for them.
+package keys
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// KeyMap holds every key binding. Bindings are grouped by the context in which
+// they apply, see Contexts.
+type KeyMap struct {
+	// General keys, available in the PR list.
+	Quit    key.Binding
+	Help    key.Binding
+	Open    key.Binding
+	NextTab key.Binding
+	PrevTab key.Binding
+
+	// Navigation keys of the PR list and of the checks view.
+	LineUp     key.Binding
+	LineDown   key.Binding
+	PageUp     key.Binding
+	PageDown   key.Binding
+	GotoTop    key.Binding
+	GotoBottom key.Binding
+
+	// Query keys change which PRs are fetched.
+	Reload          key.Binding
+	ToggleDrafts    key.Binding
+	ToggleClosed    key.Binding
+	IndividualQuery key.Binding
+
+	// View keys change how the PR list is shown.
+	WideView    key.Binding
+	Sort        key.Binding
+	FlipSort    key.Binding
+	GroupBy     key.Binding
+	Collapse    key.Binding
+	CollapseAll key.Binding
+	Details     key.Binding
+	Checks      key.Binding
+	Filter      key.Binding
+	ShowSnoozed key.Binding
+
+	// Action keys act on the selected or marked PRs.
+	Approve        key.Binding
+	RequestChanges key.Binding
+	Comment        key.Binding
+	Merge          key.Binding
+	AutoMerge      key.Binding
+	UpdateBranch   key.Binding
+	Mark           key.Binding
+	MarkAll        key.Binding
+	MarkMatching   key.Binding
+	Bulk           key.Binding
+	Snooze         key.Binding
+	Pin            key.Binding
+
+	// Keys of the checks view.
+	OpenCheck   key.Binding
+	CloseChecks key.Binding
+
+	// Keys of the filter bar.
+	ApplyFilter key.Binding
+	ClearFilter key.Binding
+
+	// Keys of the review form.
+	SubmitReview key.Binding
+	CancelReview key.Binding
+
+	// Keys of confirmations and text prompts.
+	Submit key.Binding
+	Cancel key.Binding
+
+	// Keys of the bulk action summary and of the help overlay.
+	CloseBulk key.Binding
+	CloseHelp key.Binding
+}
+
+// Default returns the built in key bindings.
+func Default() KeyMap {
+	return KeyMap{
+		Quit:    key.NewBinding(key.WithKeys("esc", "q"), key.WithHelp("esc/q", "quit")),
+		Help:    key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "help")),
+		Open:    key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open in browser")),
+		NextTab: key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next view")),
+		PrevTab: key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "previous view")),
+
+		LineUp:     key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		LineDown:   key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		PageUp:     key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "page up")),
+		PageDown:   key.NewBinding(key.WithKeys("pgdown", " "), key.WithHelp("pgdn/space", "page down")),
+		GotoTop:    key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("g/home", "go to start")),
+		GotoBottom: key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("G/end", "go to end")),
+
+		Reload:          key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "reload")),
+		ToggleDrafts:    key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "include drafts")),
+		ToggleClosed:    key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "include closed")),
+		IndividualQuery: key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "query repos one by one")),
+
+		WideView:    key.NewBinding(key.WithKeys("w", "u"), key.WithHelp("w/u", "wide view")),
+		Sort:        key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort by next column")),
+		FlipSort:    key.NewBinding(key.WithKeys("S"), key.WithHelp("S", "reverse sort")),
+		GroupBy:     key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "group by next field")),
+		Collapse:    key.NewBinding(key.WithKeys("z"), key.WithHelp("z", "collapse group")),
+		CollapseAll: key.NewBinding(key.WithKeys("Z"), key.WithHelp("Z", "collapse all groups")),
+		Details:     key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "details pane")),
+		Checks:      key.NewBinding(key.WithKeys("C"), key.WithHelp("C", "show checks")),
+		Filter:      key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+		ShowSnoozed: key.NewBinding(key.WithKeys("H"), key.WithHelp("H", "show snoozed")),
+
+		Approve:        key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "approve")),
+		RequestChanges: key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "request changes")),
+		Comment:        key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "comment")),
+		Merge:          key.NewBinding(key.WithKeys("M"), key.WithHelp("M", "merge")),
+		AutoMerge:      key.NewBinding(key.WithKeys("A"), key.WithHelp("A", "auto-merge")),
+		UpdateBranch:   key.NewBinding(key.WithKeys("U"), key.WithHelp("U", "update branch")),
+		Mark:           key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "mark")),
+		MarkAll:        key.NewBinding(key.WithKeys("ctrl+a"), key.WithHelp("ctrl+a", "mark all")),
+		MarkMatching:   key.NewBinding(key.WithKeys("*"), key.WithHelp("*", "mark matching")),
+		Bulk:           key.NewBinding(key.WithKeys("B"), key.WithHelp("B", "bulk action")),
+		Snooze:         key.NewBinding(key.WithKeys("h"), key.WithHelp("h", "snooze")),
+		Pin:            key.NewBinding(key.WithKeys("P"), key.WithHelp("P", "pin")),
+
+		OpenCheck:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open check")),
+		CloseChecks: key.NewBinding(key.WithKeys("esc", "q", "C"), key.WithHelp("esc/q/C", "back to PRs")),
+
+		ApplyFilter: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "keep filter")),
+		ClearFilter: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "clear filter")),
+
+		SubmitReview: key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "submit")),
+		CancelReview: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+
+		Submit: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "submit")),
+		Cancel: key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+
+		CloseBulk: key.NewBinding(key.WithKeys("esc", "q", "B"), key.WithHelp("esc/q/B", "close")),
+		CloseHelp: key.NewBinding(key.WithKeys("esc", "q", "?"), key.WithHelp("esc/q/?", "close")),
+	}
+}
+
+// Context is a set of key bindings that apply together.
+type Context struct {
+	Name     string
+	Bindings []key.Binding
+}
+
+// Contexts returns the key bindings grouped by the context in which they
+// apply, in the order they are listed in the help overlay.
+func (k KeyMap) Contexts() []Context {
+	return []Context{
+		{Name: "General", Bindings: []key.Binding{k.Open, k.NextTab, k.PrevTab, k.Help, k.Quit}},
+		{Name: "Navigation", Bindings: []key.Binding{k.LineUp, k.LineDown, k.PageUp, k.PageDown, k.GotoTop, k.GotoBottom}},
+		{Name: "Query", Bindings: []key.Binding{k.Reload, k.ToggleDrafts, k.ToggleClosed, k.IndividualQuery}},
+		{Name: "View", Bindings: []key.Binding{k.WideView, k.Sort, k.FlipSort, k.GroupBy, k.Collapse, k.CollapseAll, k.Details, k.Checks, k.Filter, k.ShowSnoozed}},
+		{Name: "Actions", Bindings: []key.Binding{k.Approve, k.RequestChanges, k.Comment, k.Merge, k.AutoMerge, k.UpdateBranch, k.Snooze, k.Pin}},
+		{Name: "Marking", Bindings: []key.Binding{k.Mark, k.MarkAll, k.MarkMatching, k.Bulk}},
+		{Name: "Checks", Bindings: []key.Binding{k.OpenCheck, k.CloseChecks}},
+		{Name: "Filter", Bindings: []key.Binding{k.ApplyFilter, k.ClearFilter}},
+		{Name: "Review", Bindings: []key.Binding{k.SubmitReview, k.CancelReview}},
+		{Name: "Prompts", Bindings: []key.Binding{k.Submit, k.Cancel}},
+	}
+}
+
+// ShortHelp implements help.KeyMap with the keys shown in the footer.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Help, k.Open, k.Filter, k.Reload, k.Details, k.Quit}
+}
+
+// FullHelp implements help.KeyMap with a column per context.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	columns := [][]key.Binding{}
+	for _, context := range k.Contexts() {
+		columns = append(columns, context.Bindings)
+	}
+	return columns
+}
+
+// Table returns the navigation keys as the key map of a table.
+func (k KeyMap) Table() table.KeyMap {
+	return table.KeyMap{
+		LineUp:     k.LineUp,
+		LineDown:   k.LineDown,
+		PageUp:     k.PageUp,
+		PageDown:   k.PageDown,
+		GotoTop:    k.GotoTop,
+		GotoBottom: k.GotoBottom,
+	}
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// actionMsg carries the pull request as it is after an action changed it.
+type actionMsg struct {
+	action string
+	host   string
+	pr     result.Result[github.PullRequest]
+}
+
+// actionFunc changes a pull request through the given client and returns the
+// changed pull request.
+type actionFunc func(ctx context.Context, client github.Client) result.Result[github.PullRequest]
+
+// runAction returns a command running the named action on the given pull
+// request with the client of its host.
+func (m *Model) runAction(action string, pr github.PullRequest, fn actionFunc) tea.Cmd {
+	client := m.clientFor(pr.Host)
+	return func() tea.Msg {
+		return actionMsg{
+			action: action,
+			host:   pr.Host,
+			pr:     fn(m.ctx, client),
+		}
+	}
+}
+
+// handleAction refreshes the rows of the changed pull request, or shows why
+// the action failed in the footer.
+func (m *Model) handleAction(msg actionMsg) (tea.Model, tea.Cmd) {
+	if msg.pr.IsError() {
+		m.error = msg.action + " failed: " + msg.pr.Error().Error()
+		return m, nil
+	}
+	m.error = ""
+	m.updatePullRequest(msg.host, msg.pr.MustGet())
+	return m, nil
+}
+
+// updatePullRequest refreshes the rows of the given pull request in every tab.
+func (m *Model) updatePullRequest(host string, pr github.PullRequest) {
+	pr.Host = host
+	for _, t := range m.tabs {
+		t.table.UpdatePullRequest(pr)
+	}
+}
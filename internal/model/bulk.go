@@ -0,0 +1,199 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/prtable"
+	"github.com/mrxk/gh-my/internal/theme"
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// bulkRun tracks an action run on every marked pull request of a table.
+type bulkRun struct {
+	action   string
+	table    *prtable.PRTable
+	total    int
+	done     int
+	failures []bulkFailure
+}
+
+// bulkFailure is a pull request on which a bulk action failed, along with the
+// reason.
+type bulkFailure struct {
+	pr  github.PullRequest
+	err error
+}
+
+// bulkFunc returns the action run on the given pull request.
+type bulkFunc func(pr github.PullRequest) actionFunc
+
+// bulkStartMsg starts the named bulk action on the marked pull requests.
+type bulkStartMsg struct {
+	action string
+	fn     bulkFunc
+}
+
+// bulkMsg carries the outcome of a bulk action on a single pull request.
+type bulkMsg struct {
+	run *bulkRun
+	pr  github.PullRequest
+	res result.Result[github.PullRequest]
+}
+
+// bulk returns a command starting the named bulk action.
+func bulk(action string, fn bulkFunc) tea.Cmd {
+	return func() tea.Msg {
+		return bulkStartMsg{action: action, fn: fn}
+	}
+}
+
+// startBulk asks which action to run on the marked pull requests of the
+// current table.
+func (m *Model) startBulk() {
+	marked := len(m.currentTable().MarkedPullRequests())
+	if marked == 0 {
+		m.error = "no PRs are marked"
+		return
+	}
+	m.confirm(fmt.Sprintf("Bulk action on %d PRs:", marked),
+		choice{key: "l", label: "add label", cmd: prompt("Label to add:", func(label string) tea.Cmd {
+			return bulk("add label "+label, func(pr github.PullRequest) actionFunc {
+				return func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+					return github.AddLabel(ctx, client, pr, label)
+				}
+			})
+		})},
+		choice{key: "L", label: "remove label", cmd: prompt("Label to remove:", func(label string) tea.Cmd {
+			return bulk("remove label "+label, func(pr github.PullRequest) actionFunc {
+				return func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+					return github.RemoveLabel(ctx, client, pr, label)
+				}
+			})
+		})},
+		choice{key: "v", label: "request reviewer", cmd: prompt("Reviewer (login or org/team):", func(reviewer string) tea.Cmd {
+			return bulk("request review from "+reviewer, func(pr github.PullRequest) actionFunc {
+				return func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+					return github.RequestReviewer(ctx, client, pr.ID, reviewer)
+				}
+			})
+		})},
+		choice{key: "c", label: "close", cmd: ask(fmt.Sprintf("Close %d PRs?", marked), choice{
+			key:   "y",
+			label: "yes",
+			cmd: bulk("close", func(pr github.PullRequest) actionFunc {
+				return func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+					return github.ClosePullRequest(ctx, client, pr.ID)
+				}
+			}),
+		})},
+		choice{key: "d", label: "convert to draft", cmd: bulk("convert to draft", func(pr github.PullRequest) actionFunc {
+			return func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+				return github.ConvertToDraft(ctx, client, pr.ID)
+			}
+		})},
+		choice{key: "r", label: "mark ready", cmd: bulk("mark ready", func(pr github.PullRequest) actionFunc {
+			return func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+				return github.MarkReady(ctx, client, pr.ID)
+			}
+		})},
+		choice{key: "a", label: "enable auto-merge", cmd: ask("Auto-merge method:", bulkAutoMergeChoices()...)},
+	)
+}
+
+// bulkAutoMergeChoices returns a choice enabling auto-merge with each merge
+// method. Pull requests whose repository does not allow the chosen method
+// fail.
+func bulkAutoMergeChoices() []choice {
+	choices := []choice{}
+	for _, method := range []github.MergeMethod{github.Merge, github.Squash, github.Rebase} {
+		name := strings.ToLower(string(method))
+		choices = append(choices, choice{
+			key:   mergeMethodKeys[method],
+			label: name,
+			cmd: bulk("enable auto-merge", func(pr github.PullRequest) actionFunc {
+				return func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+					if !slices.Contains(pr.MergeMethods(), method) {
+						return result.Error[github.PullRequest](fmt.Errorf("%s merges are not allowed in %s", name, pr.Repository.NameWithOwner))
+					}
+					return github.EnableAutoMerge(ctx, client, pr.ID, method)
+				}
+			}),
+		})
+	}
+	return choices
+}
+
+// runBulk runs the given bulk action on the marked pull requests of the
+// current table, at most concurrency at a time, and shows its progress.
+func (m *Model) runBulk(msg bulkStartMsg) tea.Cmd {
+	t := m.currentTable()
+	prs := t.MarkedPullRequests()
+	run := &bulkRun{action: msg.action, table: t, total: len(prs)}
+	m.bulk = run
+	m.showBulk = true
+	semaphore := make(chan struct{}, m.concurrency)
+	cmds := make([]tea.Cmd, 0, len(prs))
+	for _, pr := range prs {
+		client := m.clientFor(pr.Host)
+		fn := msg.fn(pr)
+		cmds = append(cmds, func() tea.Msg {
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			return bulkMsg{run: run, pr: pr, res: fn(m.ctx, client)}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleBulk records the outcome of a bulk action on a pull request. Rows of
+// the pull requests it changed are refreshed and unmarked so that only the
+// failed ones stay marked.
+func (m *Model) handleBulk(msg bulkMsg) (tea.Model, tea.Cmd) {
+	msg.run.done++
+	if msg.res.IsError() {
+		msg.run.failures = append(msg.run.failures, bulkFailure{pr: msg.pr, err: msg.res.Error()})
+		return m, nil
+	}
+	m.updatePullRequest(msg.pr.Host, msg.res.MustGet())
+	msg.run.table.Unmark(msg.pr.ID)
+	return m, nil
+}
+
+// handleBulkKey handles keys while the bulk action summary is shown.
+func (m *Model) handleBulkKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, m.keys.CloseBulk) {
+		m.showBulk = false
+	}
+	return m, nil
+}
+
+// Status summarizes the progress of the bulk action.
+func (r *bulkRun) Status() string {
+	return fmt.Sprintf("Bulk %s: %d of %d done, %d failed", r.action, r.done, r.total, len(r.failures))
+}
+
+// bulkView renders the progress and failures of the last bulk action in place
+// of the table.
+func (m *Model) bulkView() string {
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(m.bulk.Status()), ""}
+	for _, failure := range m.bulk.failures {
+		glyph := theme.Current().Status(theme.Failure, prtable.CheckEmoji("FAILURE"))
+		lines = append(lines, fmt.Sprintf("%s %s: %s", glyph, describe(failure.pr), failure.err))
+	}
+	if len(m.bulk.failures) == 0 && m.bulk.done == m.bulk.total {
+		lines = append(lines, "No failures")
+	}
+	return lipgloss.NewStyle().
+		Width(m.tableWidth).
+		Height(m.bodyHeight).
+		MaxWidth(m.tableWidth).
+		MaxHeight(m.bodyHeight).
+		Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// choice is an answer to a confirmation along with the key selecting it and
+// the command it runs.
+type choice struct {
+	key   string
+	label string
+	cmd   tea.Cmd
+}
+
+// confirmation asks a question in the footer and runs the command of the
+// chosen answer.
+type confirmation struct {
+	question string
+	choices  []choice
+}
+
+// confirm asks the given question before running the command of one of the
+// given choices.
+func (m *Model) confirm(question string, choices ...choice) {
+	m.confirmation = &confirmation{question: question, choices: choices}
+}
+
+// handleConfirmKey handles keys while a confirmation is shown. The key of a
+// choice runs its command and the cancel key dismisses the confirmation.
+// Other keys, and pasted text, are ignored so that only a key press picks a
+// choice.
+func (m *Model) handleConfirmKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Paste {
+		return m, nil
+	}
+	if key.Matches(msg, m.keys.Cancel) {
+		m.confirmation = nil
+		return m, nil
+	}
+	for _, c := range m.confirmation.choices {
+		if msg.String() == c.key {
+			m.confirmation = nil
+			return m, c.cmd
+		}
+	}
+	return m, nil
+}
+
+// View renders the question followed by the key of each choice and the given
+// cancel key.
+func (c *confirmation) View(cancel key.Binding) string {
+	var b strings.Builder
+	b.WriteString(c.question)
+	for _, choice := range c.choices {
+		fmt.Fprintf(&b, " [%s] %s", choice.key, choice.label)
+	}
+	b.WriteString(" " + bindingsHelp(cancel))
+	return b.String()
+}
+
+// confirmMsg asks for confirmation from a command.
+type confirmMsg struct {
+	confirmation *confirmation
+}
+
+// ask returns a command asking the given question before running the command
+// of one of the given choices.
+func ask(question string, choices ...choice) tea.Cmd {
+	return func() tea.Msg {
+		return confirmMsg{confirmation: &confirmation{question: question, choices: choices}}
+	}
+}
+
+// textPrompt asks for a line of text in the footer and passes it to submit.
+type textPrompt struct {
+	input  textinput.Model
+	submit func(string) tea.Cmd
+}
+
+// promptMsg asks for a line of text from a command.
+type promptMsg struct {
+	question string
+	submit   func(string) tea.Cmd
+}
+
+// prompt returns a command asking the given question and passing the answer
+// to submit.
+func prompt(question string, submit func(string) tea.Cmd) tea.Cmd {
+	return func() tea.Msg {
+		return promptMsg{question: question, submit: submit}
+	}
+}
+
+// startPrompt opens the text prompt of the given message.
+func (m *Model) startPrompt(msg promptMsg) tea.Cmd {
+	input := textinput.New()
+	input.Prompt = msg.question + " "
+	m.prompt = &textPrompt{input: input, submit: msg.submit}
+	return m.prompt.input.Focus()
+}
+
+// handlePromptKey handles keys while a text prompt is shown. The submit key
+// submits the text, unless it is empty, and the cancel key dismisses the
+// prompt.
+func (m *Model) handlePromptKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Submit):
+		p := m.prompt
+		m.prompt = nil
+		value := strings.TrimSpace(p.input.Value())
+		if value == "" {
+			return m, nil
+		}
+		return m, p.submit(value)
+	case key.Matches(msg, m.keys.Cancel):
+		m.prompt = nil
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.prompt.input, cmd = m.prompt.input.Update(msg)
+	return m, cmd
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// detailsMsg carries the details of the pull request identified by key.
+type detailsMsg struct {
+	key     string
+	details result.Result[github.PullRequestDetails]
+}
+
+// toggleDetails shows or hides the details pane.
+func (m *Model) toggleDetails() {
+	m.showDetails = !m.showDetails
+	m.detailsKey = ""
+	m.layout()
+}
+
+// syncDetails keeps the details pane on the pull request under the cursor. It
+// returns a command fetching the details when the selected pull request, or
+// its last update, changed.
+func (m *Model) syncDetails() tea.Cmd {
+	if !m.showDetails {
+		return nil
+	}
+	pr, ok := m.currentTable().SelectedPullRequest()
+	if !ok {
+		m.detailsKey = ""
+		m.details.Clear()
+		return nil
+	}
+	key := pr.ID + "@" + pr.UpdatedAt
+	if key == m.detailsKey {
+		return nil
+	}
+	m.detailsKey = key
+	m.details.SetLoading()
+	client := m.clientFor(pr.Host)
+	return func() tea.Msg {
+		return detailsMsg{key: key, details: m.detailsCache.Get(m.ctx, client, pr)}
+	}
+}
+
+func (m *Model) handleDetails(msg detailsMsg) (tea.Model, tea.Cmd) {
+	if msg.key == m.detailsKey {
+		m.details.SetDetails(msg.details)
+	}
+	return m, nil
+}
+
+// clientFor returns the client of the named host, or of the first host when
+// the name is unknown.
+func (m *Model) clientFor(name string) github.Client {
+	for _, host := range m.hosts {
+		if host.Name == name {
+			return host.Client
+		}
+	}
+	return m.hosts[0].Client
+}
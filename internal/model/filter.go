@@ -0,0 +1,53 @@
+package model
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mrxk/gh-my/internal/prtable"
+)
+
+func newFilterInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = "/"
+	input.Placeholder = "title, author:login, repo:name, checks:failing, -draft"
+	return input
+}
+
+// startFilter opens the filter bar with the current filter.
+func (m *Model) startFilter() tea.Cmd {
+	m.filtering = true
+	m.filterInput.SetValue(m.filter.String())
+	m.filterInput.CursorEnd()
+	return m.filterInput.Focus()
+}
+
+// handleFilterKey handles keys while the filter bar is open. Rows are
+// narrowed as the filter is typed. Enter keeps the filter and closes the bar
+// while esc clears the filter.
+func (m *Model) handleFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.ApplyFilter):
+		m.filtering = false
+		m.filterInput.Blur()
+		return m, nil
+	case key.Matches(msg, m.keys.ClearFilter):
+		m.filtering = false
+		m.filterInput.Blur()
+		m.setFilter("")
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	m.setFilter(m.filterInput.Value())
+	return m, cmd
+}
+
+// setFilter applies the given filter text to every tab so that the filter
+// survives tab switches.
+func (m *Model) setFilter(text string) {
+	m.filter = prtable.ParseFilter(text)
+	for _, t := range m.tabs {
+		t.table.SetFilter(m.filter)
+	}
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/prtable"
+	"github.com/mrxk/gh-my/internal/report"
+)
+
+// Print runs the query of the start tab, as the TUI would, and writes the
+// pull requests found to w in the given format instead of showing them. The
+// wide view is used when wide is true. Partial results are written when some
+// repositories fail, and the failed repositories are then returned as an
+// error.
+func (m *Model) Print(w io.Writer, format prtable.Format, wide bool) error {
+	return m.print(func(results github.PullRequestSearchResults, view prtable.Options) error {
+		return prtable.Write(w, format, results, view, wide)
+	})
+}
+
+// PrintTemplate is like Print but renders the pull requests, in the sort
+// order of the default view, with the given template.
+func (m *Model) PrintTemplate(w io.Writer, tmpl *template.Template) error {
+	return m.print(func(results github.PullRequestSearchResults, view prtable.Options) error {
+		return report.Execute(w, tmpl, prtable.Sorted(results, view, false))
+	})
+}
+
+// print runs the query of the start tab and passes the results along with
+// the view of the tab to write.
+func (m *Model) print(write func(github.PullRequestSearchResults, prtable.Options) error) error {
+	msg := m.fetch(m.selectedTab, m.pinned).(searchResultsMsg)
+	if msg.searchResults.IsError() {
+		return msg.searchResults.Error()
+	}
+	err := write(msg.searchResults.MustGet(), m.tabs[m.selectedTab].view)
+	if err != nil {
+		return err
+	}
+	if len(msg.failedRepositories) != 0 {
+		return fmt.Errorf("failed to query %s", strings.Join(msg.failedRepositories, ", "))
+	}
+	return nil
+}
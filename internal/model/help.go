@@ -0,0 +1,78 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/soft-serve/pkg/ui/components/tabs"
+)
+
+// bindingsHelp renders the given key bindings as "[key] description" for the
+// footer.
+func bindingsHelp(bindings ...key.Binding) string {
+	parts := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		if !binding.Enabled() {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("[%s] %s", binding.Help().Key, binding.Help().Desc))
+	}
+	return strings.Join(parts, " ")
+}
+
+// selectTab shows the tab at the given index, wrapping around at both ends.
+func (m *Model) selectTab(i int) tea.Cmd {
+	idx := TabIndex((i + len(m.tabs)) % len(m.tabs))
+	return tea.Batch(tabs.SelectTabCmd(int(idx)), m.activateTab(idx))
+}
+
+// handleHelpKey handles keys while the help overlay is shown.
+func (m *Model) handleHelpKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, m.keys.CloseHelp) {
+		m.showHelp = false
+	}
+	return m, nil
+}
+
+// helpView renders every key binding, grouped by context, in place of the
+// table. Contexts are laid out in as many columns as fit the window.
+func (m *Model) helpView() string {
+	titleStyle := lipgloss.NewStyle().Bold(true)
+	blocks := []string{}
+	for _, context := range m.keys.Contexts() {
+		blocks = append(blocks, lipgloss.JoinVertical(lipgloss.Left,
+			titleStyle.Render(context.Name),
+			m.help.FullHelpView([][]key.Binding{context.Bindings}),
+			"",
+		))
+	}
+	rows := []string{}
+	row := []string{}
+	rowWidth := 0
+	for _, block := range blocks {
+		width := lipgloss.Width(block) + 4
+		if len(row) != 0 && rowWidth+width > m.tableWidth {
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+			row, rowWidth = nil, 0
+		}
+		row = append(row, lipgloss.NewStyle().PaddingRight(4).Render(block))
+		rowWidth += width
+	}
+	if len(row) != 0 {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+	}
+	return lipgloss.NewStyle().
+		Width(m.tableWidth).
+		Height(m.bodyHeight).
+		MaxWidth(m.tableWidth).
+		MaxHeight(m.bodyHeight).
+		Render(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// shortHelpView renders the most used key bindings below the footer.
+func (m *Model) shortHelpView() string {
+	return m.help.ShortHelpView(m.keys.ShortHelp())
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// mergeMethodKeys are the keys choosing each merge method in a confirmation.
+var mergeMethodKeys = map[github.MergeMethod]string{
+	github.Merge:  "m",
+	github.Squash: "s",
+	github.Rebase: "r",
+}
+
+// describe names the given pull request for a confirmation.
+func describe(pr github.PullRequest) string {
+	return fmt.Sprintf("%s#%d", pr.Repository.NameWithOwner, pr.Number)
+}
+
+// startMerge asks how to merge the selected pull request, offering the merge
+// methods allowed by its repository.
+func (m *Model) startMerge() {
+	pr, ok := m.currentTable().SelectedPullRequest()
+	if !ok {
+		return
+	}
+	choices := m.mergeChoices(pr, "merge", func(method github.MergeMethod) actionFunc {
+		return func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+			return github.MergePullRequest(ctx, client, pr.ID, method)
+		}
+	})
+	if len(choices) == 0 {
+		m.error = "merge failed: no merge method is allowed in " + pr.Repository.NameWithOwner
+		return
+	}
+	m.confirm("Merge "+describe(pr)+"?", choices...)
+}
+
+// startAutoMerge asks how to automatically merge the selected pull request or,
+// when auto-merge is already enabled, whether to disable it.
+func (m *Model) startAutoMerge() {
+	pr, ok := m.currentTable().SelectedPullRequest()
+	if !ok {
+		return
+	}
+	if pr.AutoMergeRequest != nil {
+		m.confirm("Disable auto-merge of "+describe(pr)+"?", choice{
+			key:   "y",
+			label: "yes",
+			cmd: m.runAction("disable auto-merge", pr, func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+				return github.DisableAutoMerge(ctx, client, pr.ID)
+			}),
+		})
+		return
+	}
+	choices := m.mergeChoices(pr, "enable auto-merge", func(method github.MergeMethod) actionFunc {
+		return func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+			return github.EnableAutoMerge(ctx, client, pr.ID, method)
+		}
+	})
+	if len(choices) == 0 {
+		m.error = "enable auto-merge failed: no merge method is allowed in " + pr.Repository.NameWithOwner
+		return
+	}
+	m.confirm("Enable auto-merge of "+describe(pr)+"?", choices...)
+}
+
+// startUpdateBranch asks whether to merge the base branch into the selected
+// pull request when it is behind.
+func (m *Model) startUpdateBranch() {
+	pr, ok := m.currentTable().SelectedPullRequest()
+	if !ok {
+		return
+	}
+	if !pr.IsBehind() {
+		m.error = describe(pr) + " is not behind its base branch"
+		return
+	}
+	m.confirm("Update the branch of "+describe(pr)+"?", choice{
+		key:   "y",
+		label: "yes",
+		cmd: m.runAction("update branch", pr, func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+			return github.UpdateBranch(ctx, client, pr.ID)
+		}),
+	})
+}
+
+// mergeChoices returns a choice for each merge method allowed by the
+// repository of the given pull request, running the named action returned by
+// fn.
+func (m *Model) mergeChoices(pr github.PullRequest, action string, fn func(github.MergeMethod) actionFunc) []choice {
+	choices := []choice{}
+	for _, method := range pr.MergeMethods() {
+		choices = append(choices, choice{
+			key:   mergeMethodKeys[method],
+			label: strings.ToLower(string(method)),
+			cmd:   m.runAction(action, pr, fn(method)),
+		})
+	}
+	return choices
+}
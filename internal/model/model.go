@@ -7,14 +7,23 @@ import (
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/soft-serve/pkg/ui/common"
 	"github.com/charmbracelet/soft-serve/pkg/ui/components/tabs"
 	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/keys"
+	"github.com/mrxk/gh-my/internal/notify"
+	"github.com/mrxk/gh-my/internal/prchecks"
+	"github.com/mrxk/gh-my/internal/prdetails"
 	"github.com/mrxk/gh-my/internal/prtable"
-	"github.com/sassoftware/sas-ggdk/pkg/result"
+	"github.com/mrxk/gh-my/internal/state"
+	"github.com/mrxk/gh-my/internal/theme"
 )
 
 // Ensure that Model implements tea.Model.
@@ -33,87 +42,217 @@ const (
 // tickMsg is the message returned from a tick
 type tickMsg time.Time
 
-// Update the model with search results
-type searchResultsMsg struct {
-	selectedTab   TabIndex
-	searchResults result.Result[github.PullRequestSearchResults]
-}
-
 type Model struct {
+	ctx                 context.Context
 	selectedTab         TabIndex
 	topTabs             *tabs.Tabs
-	myPRs               *prtable.PRTable
-	myRequests          *prtable.PRTable
-	allPRs              *prtable.PRTable
+	tabs                []*tab
 	height              int
 	width               int
+	tableWidth          int
 	error               string
 	individualRepoQuery bool
 	includeClosed       bool
 	includeDrafts       bool
 	prListUpdated       time.Time
 	interval            time.Duration
-	repositories        []string
+	limit               int
+	concurrency         int
+	hosts               []Host
+	failedRepositories  map[TabIndex][]string
+	rateLimits          *github.RateLimitTracker
+	refreshCost         int
+	pollDelay           time.Duration
+	details             *prdetails.Pane
+	detailsCache        *github.DetailsCache
+	detailsKey          string
+	showDetails         bool
+	checks              *prchecks.Checks
+	showChecks          bool
+	filter              prtable.Filter
+	filterInput         textinput.Model
+	filtering           bool
+	reviewInput         textarea.Model
+	reviewEvent         github.ReviewEvent
+	reviewPR            github.PullRequest
+	reviewing           bool
+	confirmation        *confirmation
+	prompt              *textPrompt
+	bulk                *bulkRun
+	showBulk            bool
+	notifier            *notify.Notifier
+	snapshots           map[TabIndex]snapshot
+	store               *state.Store
+	seen                map[string]state.Seen
+	snoozed             map[string]state.Snooze
+	pinned              map[string]state.Pin
+	seenURL             string
+	keys                keys.KeyMap
+	help                help.Model
+	showHelp            bool
+	bodyHeight          int
+}
+
+// Host is a GitHub host to query along with the client used to reach it and
+// the repositories used for its repository scoped queries.
+type Host struct {
+	Name         string
+	Client       github.Client
+	Repositories []string
 }
 
 type Options struct {
 	Context             context.Context
+	Hosts               []Host
 	IndividualRepoQuery bool
 	IncludeClosed       bool
 	IncludeDrafts       bool
 	StartTab            TabIndex
 	Interval            time.Duration
-	Repositories        []string
+	Limit               int
+	Concurrency         int
 	DefaultView         []prtable.Column
 	WideView            []prtable.Column
+	DefaultSort         *prtable.Sort
+	WideSort            *prtable.Sort
+	GroupBy             prtable.GroupBy
+	Tabs                []TabOptions
+	Notifications       *notify.Options
+	Store               *state.Store
+	// Keys are the key bindings, the built in ones when nil.
+	Keys *keys.KeyMap
 }
 
 func New(opts Options) *Model {
-	m := &Model{}
+	m := &Model{ctx: opts.Context}
+	if m.ctx == nil {
+		m.ctx = context.Background()
+	}
+	m.keys = keys.Default()
+	if opts.Keys != nil {
+		m.keys = *opts.Keys
+	}
+	m.help = help.New()
 	delegate := list.NewDefaultDelegate()
 	delegate.ShowDescription = false
 	delegate.SetSpacing(0) // compact lists
-	m.topTabs = tabs.New(common.NewCommon(opts.Context, lipgloss.DefaultRenderer(), 0, 0), []string{"My PRs", "My Requests", "All PRs"})
-	m.myPRs = prtable.New(m.fetchMyPullRequests, opts.DefaultView, opts.WideView)
-	m.myRequests = prtable.New(m.fetchMyRequests, opts.DefaultView, opts.WideView)
-	m.allPRs = prtable.New(m.fetchAllPullRequets, opts.DefaultView, opts.WideView)
+	m.tabs = m.newTabs(opts)
+	names := make([]string, 0, len(m.tabs))
+	for _, t := range m.tabs {
+		names = append(names, t.name)
+	}
+	m.topTabs = tabs.New(common.NewCommon(opts.Context, lipgloss.DefaultRenderer(), 0, 0), names)
 	m.individualRepoQuery = opts.IndividualRepoQuery
 	m.includeClosed = opts.IncludeClosed
 	m.includeDrafts = opts.IncludeDrafts
 	m.selectedTab = opts.StartTab
 	m.interval = opts.Interval
-	m.repositories = opts.Repositories
+	m.limit = opts.Limit
+	m.concurrency = opts.Concurrency
+	if m.concurrency <= 0 {
+		m.concurrency = defaultConcurrency
+	}
+	m.failedRepositories = map[TabIndex][]string{}
+	m.rateLimits = github.NewRateLimitTracker()
+	m.hosts = make([]Host, 0, len(opts.Hosts))
+	for _, host := range opts.Hosts {
+		host.Client = m.rateLimits.Client(host.Client)
+		m.hosts = append(m.hosts, host)
+	}
+	m.details = prdetails.New()
+	m.detailsCache = github.NewDetailsCache()
+	m.checks = prchecks.New(m.keys.Table())
+	m.filterInput = newFilterInput()
+	m.reviewInput = newReviewInput()
+	if opts.Notifications != nil {
+		m.notifier = notify.New(*opts.Notifications)
+	}
+	m.snapshots = map[TabIndex]snapshot{}
+	m.store = opts.Store
+	if m.store != nil {
+		seen, err := m.store.Seen()
+		if err != nil {
+			m.error = "failed to load state: " + err.Error()
+			seen = map[string]state.Seen{}
+		}
+		m.seen = seen
+		snoozed, err := m.store.Snoozed()
+		if err != nil {
+			m.error = "failed to load state: " + err.Error()
+			snoozed = map[string]state.Snooze{}
+		}
+		m.snoozed = snoozed
+		pinned, err := m.store.Pinned()
+		if err != nil {
+			m.error = "failed to load state: " + err.Error()
+			pinned = map[string]state.Pin{}
+		}
+		m.pinned = pinned
+		for _, t := range m.tabs {
+			t.table.SetSeen(m.seen)
+			t.table.SetSnoozed(m.snoozed)
+			t.table.SetPinned(m.pinned)
+		}
+	}
 	return m
 }
 
 // Init implements tea.Model.
 func (m *Model) Init() tea.Cmd {
 	cmds := []tea.Cmd{tea.SetWindowTitle("Github Pull Requests")}
-	switch m.selectedTab {
-	case MyPRsTab:
-		cmds = append(cmds, m.myPRs.Focus())
-	case MyRequestsTab:
-		cmds = append(cmds, m.myRequests.Focus())
-	case AllPRsTab:
-		cmds = append(cmds, m.allPRs.Focus())
-	}
+	cmds = append(cmds, m.currentTable().Focus())
 	if m.interval != 0 {
-		cmds = append(cmds, doTick(m.interval))
+		m.pollDelay = m.interval
+		cmds = append(cmds, doTick(m.pollDelay))
 	}
 	cmds = append(cmds, tabs.SelectTabCmd(int(m.selectedTab)))
 	return tea.Batch(cmds...)
 }
 
-// Update implements tea.Model.
+// Update implements tea.Model. A pull request counts as viewed when the cursor
+// of the PR list is moved onto it or when its details are opened.
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, pressed := msg.(tea.KeyMsg)
+	moved := pressed && m.listFocused() && m.moved(keyMsg)
+	tab, before := m.selectedTab, m.currentTable().GetSelectedPRURL()
+	showedDetails := m.showDetails
+	newModel, cmd := m.update(msg)
+	moved = moved && m.selectedTab == tab && m.currentTable().GetSelectedPRURL() != before
+	opened := m.showDetails && !showedDetails
+	return newModel, tea.Batch(cmd, m.syncSeen(moved || opened), m.syncDetails())
+}
+
+func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	cmds := make([]tea.Cmd, 0, 4)
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		return m.handleWindowSize(msg)
 	case tickMsg:
-		return m, tea.Batch(m.reload, doTick(m.interval))
+		m.pollDelay = m.nextPollDelay()
+		return m, tea.Batch(m.reload, doTick(m.pollDelay))
 	case tea.KeyMsg:
+		if m.filtering {
+			return m.handleFilterKey(msg)
+		}
+		if m.reviewing {
+			return m.handleReviewKey(msg)
+		}
+		if m.confirmation != nil {
+			return m.handleConfirmKey(msg)
+		}
+		if m.prompt != nil {
+			return m.handlePromptKey(msg)
+		}
+		if m.showBulk {
+			return m.handleBulkKey(msg)
+		}
+		if m.showChecks {
+			return m.handleChecksKey(msg)
+		}
+		if m.showHelp {
+			return m.handleHelpKey(msg)
+		}
 		newModel, cmd, handled := m.handleGlobalKey(msg)
 		if handled {
 			return newModel, cmd
@@ -121,44 +260,87 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 	case searchResultsMsg:
 		return m.handleSearchResults(msg)
+	case detailsMsg:
+		return m.handleDetails(msg)
+	case actionMsg:
+		return m.handleAction(msg)
+	case confirmMsg:
+		m.confirmation = msg.confirmation
+		return m, nil
+	case promptMsg:
+		return m, m.startPrompt(msg)
+	case bulkStartMsg:
+		return m, m.runBulk(msg)
+	case bulkMsg:
+		return m.handleBulk(msg)
+	case notifyMsg:
+		return m.handleNotify(msg)
+	case snoozeMsg:
+		m.handleSnooze(msg)
+		return m, nil
+	case pinMsg:
+		m.handlePin(msg)
+		return m, nil
+	case seenMsg:
+		m.handleSeen(msg)
+		return m, nil
+	case prtable.ReloadMsg:
+		for _, t := range m.tabs {
+			t.table, cmd = t.table.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+		return m, tea.Batch(cmds...)
 	case tabs.ActiveTabMsg:
 		cmd = m.activateTab(TabIndex(msg))
 		return m, cmd
 	}
-	var newTabs tea.Model
-	newTabs, cmd = m.topTabs.Update(msg)
-	cmds = append(cmds, cmd)
-	m.topTabs = newTabs.(*tabs.Tabs)
-
-	m.myPRs, cmd = m.myPRs.Update(msg)
-	cmds = append(cmds, cmd)
+	// Tabs are switched with the configured keys rather than the ones the tab
+	// bar knows about.
+	if _, ok := msg.(tea.KeyMsg); !ok {
+		var newTabs tea.Model
+		newTabs, cmd = m.topTabs.Update(msg)
+		cmds = append(cmds, cmd)
+		m.topTabs = newTabs.(*tabs.Tabs)
+	}
 
-	m.myRequests, cmd = m.myRequests.Update(msg)
-	cmds = append(cmds, cmd)
+	for _, t := range m.tabs {
+		t.table, cmd = t.table.Update(msg)
+		cmds = append(cmds, cmd)
+	}
 
-	m.allPRs, cmd = m.allPRs.Update(msg)
-	cmds = append(cmds, cmd)
+	if m.filtering {
+		m.filterInput, cmd = m.filterInput.Update(msg)
+		cmds = append(cmds, cmd)
+	}
 
 	return m, tea.Batch(cmds...)
 }
 
 // View implements tea.Model.
 func (m *Model) View() string {
-	border := lipgloss.NewStyle().Border(lipgloss.NormalBorder(), true, false, true, false).BorderForeground(lipgloss.Color("#6CB0D2"))
-	var tableView string
-	var footerStatus string
-	switch m.selectedTab {
-	case MyPRsTab:
-		tableView = m.myPRs.View()
-		footerStatus = m.myPRs.Status()
-	case MyRequestsTab:
-		tableView = m.myRequests.View()
-		footerStatus = m.myRequests.Status()
-	case AllPRsTab:
-		tableView = m.allPRs.View()
-		footerStatus = m.allPRs.Status()
-	}
-	footer := m.footerView(footerStatus)
+	border := theme.Current().BorderStyle(true, false, true, false)
+	tableView := lipgloss.NewStyle().MaxWidth(m.tableWidth).Render(m.currentTable().View())
+	status := m.currentTable().Status()
+	if m.showChecks {
+		tableView = lipgloss.NewStyle().MaxWidth(m.tableWidth).Render(m.checks.View())
+		status = m.checks.Status()
+	}
+	if m.showBulk {
+		tableView = m.bulkView()
+		status = bindingsHelp(m.keys.CloseBulk)
+	}
+	if m.reviewing {
+		tableView = m.reviewView()
+		status = bindingsHelp(m.keys.SubmitReview, m.keys.CancelReview)
+	}
+	if m.showHelp {
+		tableView = m.helpView()
+		status = bindingsHelp(m.keys.CloseHelp)
+	}
+	if m.showDetails {
+		tableView = lipgloss.JoinHorizontal(lipgloss.Top, tableView, m.details.View())
+	}
+	footer := m.footerView(status)
 	return strings.Join(
 		[]string{
 			lipgloss.JoinVertical(lipgloss.Top,
@@ -167,6 +349,7 @@ func (m *Model) View() string {
 					border.Render(tableView),
 				),
 				footer,
+				m.shortHelpView(),
 			),
 		}, "\n")
 }
@@ -174,23 +357,21 @@ func (m *Model) View() string {
 func (m *Model) activateTab(idx TabIndex) tea.Cmd {
 	var cmd tea.Cmd
 	m.selectedTab = idx
-	switch m.selectedTab {
-	case MyPRsTab:
-		cmd = m.myPRs.Focus()
-		m.myRequests.Blur()
-		m.allPRs.Blur()
-	case MyRequestsTab:
-		m.myPRs.Blur()
-		cmd = m.myRequests.Focus()
-		m.allPRs.Blur()
-	case AllPRsTab:
-		m.myPRs.Blur()
-		m.myRequests.Blur()
-		cmd = m.allPRs.Focus()
+	for i, t := range m.tabs {
+		if TabIndex(i) == m.selectedTab {
+			cmd = t.table.Focus()
+		} else {
+			t.table.Blur()
+		}
 	}
 	return cmd
 }
 
+// currentTable returns the table of the selected tab.
+func (m *Model) currentTable() *prtable.PRTable {
+	return m.tabs[m.selectedTab].table
+}
+
 func (m *Model) footerView(status string) string {
 	footer := status
 	if m.individualRepoQuery {
@@ -202,79 +383,160 @@ func (m *Model) footerView(status string) string {
 	if m.includeDrafts {
 		footer += " [including drafts]"
 	}
+	if !m.filter.IsEmpty() {
+		footer += " [filter: " + m.filter.String() + "]"
+	}
+	if failed := m.failedRepositories[m.selectedTab]; len(failed) != 0 {
+		footer += " [failed: " + strings.Join(failed, ", ") + "]"
+	}
+	if rateLimit := m.rateLimits.RateLimit(); !rateLimit.ResetAt.IsZero() {
+		footer += fmt.Sprintf(" [%d API points left]", rateLimit.Remaining)
+	}
 	footer += " " + m.error
 	timeFooter := m.prListUpdated.Format("03:04:05 PM")
 	if m.interval != 0 {
-		timeFooter += " (🔄" + m.interval.String() + ")"
+		timeFooter += " (" + theme.Current().Glyphs.Polling + m.pollDelay.Round(time.Second).String()
+		if m.pollDelay > m.interval {
+			timeFooter += " rate limited"
+		}
+		timeFooter += ")"
+	}
+	if m.filtering {
+		footer = m.filterInput.View() + "  " + status
 	}
-	infoWidth := m.myPRs.Width() - len(timeFooter)
-	footerFormat := fmt.Sprintf("%%-%ds%%s", infoWidth)
-	return fmt.Sprintf(footerFormat, footer, timeFooter)
+	if m.confirmation != nil {
+		footer = m.confirmation.View(m.keys.Cancel)
+	}
+	if m.prompt != nil {
+		footer = m.prompt.input.View()
+	}
+	infoWidth := m.width - 2 - lipgloss.Width(timeFooter)
+	padding := strings.Repeat(" ", max(infoWidth-lipgloss.Width(footer), 0))
+	return footer + padding + timeFooter
 }
 
 func (m *Model) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 	m.height = msg.Height
 	m.width = msg.Width
 	m.topTabs.SetSize(m.width, 2)
-	m.myPRs.SetWidth(m.width - 2)
-	m.myPRs.SetHeight(m.height - 4)
-	m.myRequests.SetWidth(m.width - 2)
-	m.myRequests.SetHeight(m.height - 4)
-	m.allPRs.SetWidth(m.width - 2)
-	m.allPRs.SetHeight(m.height - 4)
+	m.layout()
 	return m, nil
 }
 
+// layout sizes the tables, and the details pane when it is shown, to fit the
+// window. Tables keep the full width, so that their rows do not wrap, and are
+// clipped to tableWidth when rendered.
+func (m *Model) layout() {
+	// The tabs, the borders, the footer and the help line take 5 lines.
+	m.bodyHeight = m.height - 5
+	m.tableWidth = m.width - 2
+	if m.showDetails {
+		m.tableWidth = (m.width - 2) / 2
+		m.details.SetSize(m.width-2-m.tableWidth, m.bodyHeight)
+	}
+	for _, t := range m.tabs {
+		t.table.SetWidth(m.width - 2)
+		t.table.SetHeight(m.bodyHeight)
+	}
+	m.checks.SetSize(m.tableWidth, m.bodyHeight)
+	m.reviewInput.SetWidth(m.tableWidth)
+	m.reviewInput.SetHeight(m.bodyHeight - 2)
+	m.help.Width = m.width - 2
+}
+
 func (m *Model) handleGlobalKey(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
 	var cmd tea.Cmd
 	var handled bool
-	switch msg.String() {
-	case "enter":
+	switch {
+	case key.Matches(msg, m.keys.Open):
 		m.openSelectedPullRequest()
 		handled = true
-	case "esc":
-		fallthrough
-	case "q":
+	case key.Matches(msg, m.keys.Quit):
 		cmd = tea.Quit
 		handled = true
-	case "d":
+	case key.Matches(msg, m.keys.Help):
+		m.showHelp = true
+		handled = true
+	case key.Matches(msg, m.keys.NextTab):
+		cmd = m.selectTab(int(m.selectedTab) + 1)
+		handled = true
+	case key.Matches(msg, m.keys.PrevTab):
+		cmd = m.selectTab(int(m.selectedTab) - 1)
+		handled = true
+	case key.Matches(msg, m.keys.ToggleDrafts):
 		cmd = tea.Batch(m.toggleDrafts, m.reload)
 		handled = false // let the other components see this message
-	case "c":
+	case key.Matches(msg, m.keys.ToggleClosed):
 		cmd = tea.Batch(m.toggleClosed, m.reload)
 		handled = false // let the other components see this message
-	case "i":
+	case key.Matches(msg, m.keys.IndividualQuery):
 		cmd = tea.Batch(m.toggleIndividualRepoQuery, m.reload)
 		handled = false // let the other components see this message
+	case key.Matches(msg, m.keys.Details):
+		m.toggleDetails()
+		handled = true
+	case key.Matches(msg, m.keys.Checks):
+		m.openChecks()
+		handled = true
+	case key.Matches(msg, m.keys.Filter):
+		cmd = m.startFilter()
+		handled = true
+	case key.Matches(msg, m.keys.Approve):
+		cmd = m.startReview(github.Approve)
+		handled = true
+	case key.Matches(msg, m.keys.RequestChanges):
+		cmd = m.startReview(github.RequestChanges)
+		handled = true
+	case key.Matches(msg, m.keys.Comment):
+		cmd = m.startReview(github.Comment)
+		handled = true
+	case key.Matches(msg, m.keys.Merge):
+		m.startMerge()
+		handled = true
+	case key.Matches(msg, m.keys.AutoMerge):
+		m.startAutoMerge()
+		handled = true
+	case key.Matches(msg, m.keys.UpdateBranch):
+		m.startUpdateBranch()
+		handled = true
+	case key.Matches(msg, m.keys.Bulk):
+		m.startBulk()
+		handled = true
+	case key.Matches(msg, m.keys.Snooze):
+		m.startSnooze()
+		handled = true
+	case key.Matches(msg, m.keys.Pin):
+		cmd = m.togglePin()
+		handled = true
 	}
 	return m, cmd, handled
 }
 
 func (m *Model) handleSearchResults(msg searchResultsMsg) (tea.Model, tea.Cmd) {
-	var cmd tea.Cmd
+	var cmd, notifyCmd tea.Cmd
 	m.prListUpdated = time.Now()
 	m.error = "" // clear any error
-	switch msg.selectedTab {
-	case MyPRsTab:
-		m.myPRs, cmd = m.myPRs.Update(msg.searchResults)
-	case MyRequestsTab:
-		m.myRequests, cmd = m.myRequests.Update(msg.searchResults)
-	case AllPRsTab:
-		m.allPRs, cmd = m.allPRs.Update(msg.searchResults)
+	m.failedRepositories[msg.selectedTab] = msg.failedRepositories
+	if !msg.searchResults.IsError() {
+		m.refreshCost = msg.searchResults.MustGet().Data.RateLimit.Cost
 	}
-	return m, cmd
+	if !msg.searchResults.IsError() && len(msg.failedRepositories) == 0 {
+		notifyCmd = m.notifyChanges(msg.selectedTab, msg.searchResults.MustGet())
+	}
+	t := m.tabs[msg.selectedTab]
+	if !msg.searchResults.IsError() {
+		t.table.SetPinnedPullRequests(msg.pinned)
+	}
+	t.table, cmd = t.table.Update(msg.searchResults)
+	return m, tea.Batch(cmd, notifyCmd)
 }
 
 func (m *Model) openSelectedPullRequest() {
-	var url string
-	switch m.selectedTab {
-	case MyPRsTab:
-		url = m.myPRs.GetSelectedPRURL()
-	case MyRequestsTab:
-		url = m.myRequests.GetSelectedPRURL()
-	case AllPRsTab:
-		url = m.allPRs.GetSelectedPRURL()
-	}
+	m.openURL(m.currentTable().GetSelectedPRURL())
+}
+
+// openURL opens the given URL in the default browser.
+func (m *Model) openURL(url string) {
 	if url == "" {
 		return
 	}
@@ -285,69 +547,32 @@ func (m *Model) openSelectedPullRequest() {
 	}
 }
 
-func (m *Model) fetchMyPullRequests() tea.Msg {
-	if m.individualRepoQuery {
-		results := result.Ok(github.PullRequestSearchResults{})
-		for _, repo := range m.repositories {
-			response := github.ExecuteQuery(
-				context.Background(),
-				github.ForMyPRs,
-				github.ForRepositories([]string{repo}),
-				github.WithClosed(m.includeClosed),
-				github.WithDrafts(m.includeDrafts),
-			)
-			results = result.MapNoError2(mergeResults, results, response)
-		}
-		return searchResultsMsg{selectedTab: MyPRsTab, searchResults: results}
-	} else {
-		response := github.ExecuteQuery(context.Background(), github.ForMyPRs, github.WithClosed(m.includeClosed), github.WithDrafts(m.includeDrafts))
-		return searchResultsMsg{selectedTab: MyPRsTab, searchResults: response}
-	}
-}
-
-func (m *Model) fetchMyRequests() tea.Msg {
-	if m.individualRepoQuery {
-		results := result.Ok(github.PullRequestSearchResults{})
-		for _, repo := range m.repositories {
-			response := github.ExecuteQuery(
-				context.Background(),
-				github.ForMyRequests,
-				github.ForRepositories([]string{repo}),
-				github.WithClosed(m.includeClosed),
-				github.WithDrafts(m.includeDrafts),
-			)
-			results = result.MapNoError2(mergeResults, results, response)
-		}
-		return searchResultsMsg{selectedTab: MyRequestsTab, searchResults: results}
-	} else {
-		response := github.ExecuteQuery(context.Background(), github.ForMyRequests, github.WithClosed(m.includeClosed), github.WithDrafts(m.includeDrafts))
-		return searchResultsMsg{selectedTab: MyRequestsTab, searchResults: response}
+// openChecks shows the checks of the selected pull request in place of the
+// table.
+func (m *Model) openChecks() {
+	pr, ok := m.currentTable().SelectedPullRequest()
+	if !ok {
+		return
 	}
+	m.checks.SetPullRequest(pr)
+	m.checks.Focus()
+	m.showChecks = true
 }
 
-func (m *Model) fetchAllPullRequets() tea.Msg {
-	if m.individualRepoQuery {
-		results := result.Ok(github.PullRequestSearchResults{})
-		for _, repo := range m.repositories {
-			response := github.ExecuteQuery(
-				context.Background(),
-				github.ForRepositories([]string{repo}),
-				github.WithClosed(m.includeClosed),
-				github.WithDrafts(m.includeDrafts),
-			)
-			results = result.MapNoError2(mergeResults, results, response)
-		}
-		return searchResultsMsg{selectedTab: AllPRsTab, searchResults: results}
-	} else {
-		response := github.ExecuteQuery(context.Background(), github.ForRepositories(m.repositories), github.WithClosed(m.includeClosed), github.WithDrafts(m.includeDrafts))
-		return searchResultsMsg{selectedTab: AllPRsTab, searchResults: response}
+// handleChecksKey handles keys while the checks of a pull request are shown.
+func (m *Model) handleChecksKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.CloseChecks):
+		m.showChecks = false
+		m.checks.Blur()
+		return m, nil
+	case key.Matches(msg, m.keys.OpenCheck):
+		m.openURL(m.checks.SelectedURL())
+		return m, nil
 	}
-}
-
-func mergeResults(acc github.PullRequestSearchResults, newResults github.PullRequestSearchResults) github.PullRequestSearchResults {
-	acc.Data.Search.Edges = append(acc.Data.Search.Edges, newResults.Data.Search.Edges...)
-	acc.Data.Search.IssueCount = len(acc.Data.Search.Edges)
-	return acc
+	var cmd tea.Cmd
+	m.checks, cmd = m.checks.Update(msg)
+	return m, cmd
 }
 
 func (m *Model) toggleDrafts() tea.Msg {
@@ -365,12 +590,30 @@ func (m *Model) toggleIndividualRepoQuery() tea.Msg {
 	return nil
 }
 
+// reload asks the tables to fetch their pull requests again without going
+// through the key bindings, so that polling neither depends on how reload is
+// bound nor reaches the dialogs handling keys.
 func (m *Model) reload() tea.Msg {
-	key := tea.Key{
-		Type:  tea.KeyRunes,
-		Runes: []rune{'r'},
+	return prtable.ReloadMsg{}
+}
+
+// nextPollDelay returns the delay until the next poll. While the remaining rate
+// limit budget can pay for a refresh every interval until it is replenished
+// the configured interval is used. Otherwise polling slows down so that the
+// budget lasts until resetAt, and stops until resetAt once it is exhausted. The
+// budget is the lowest one reported by any response while the cost of a
+// refresh is that of the last search.
+func (m *Model) nextPollDelay() time.Duration {
+	rateLimit := m.rateLimits.RateLimit()
+	untilReset := time.Until(rateLimit.ResetAt)
+	if rateLimit.ResetAt.IsZero() || untilReset <= 0 {
+		return m.interval
+	}
+	refreshes := rateLimit.Remaining / max(m.refreshCost, 1)
+	if refreshes == 0 {
+		return untilReset + time.Second
 	}
-	return tea.KeyMsg(key)
+	return max(m.interval, untilReset/time.Duration(refreshes))
 }
 
 func doTick(interval time.Duration) tea.Cmd {
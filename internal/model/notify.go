@@ -0,0 +1,92 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/notify"
+)
+
+// snapshot is the list of pull requests of a tab after a refresh along with
+// the query toggles it was loaded with. Snapshots loaded with different
+// toggles are not compared since the toggles alone change the list.
+type snapshot struct {
+	toggles string
+	prs     []github.PullRequest
+}
+
+// notifyMsg reports a failure to notify.
+type notifyMsg struct {
+	err error
+}
+
+// toggles describes the query toggles that change the pull requests listed.
+func (m *Model) toggles() string {
+	return fmt.Sprintf("%t/%t/%t", m.includeClosed, m.includeDrafts, m.individualRepoQuery)
+}
+
+// notifyChanges records the pull requests of the given tab and, in watch mode,
+// returns a command notifying of the changes since its previous refresh. The
+// first refresh of a tab only records its pull requests.
+func (m *Model) notifyChanges(idx TabIndex, results github.PullRequestSearchResults) tea.Cmd {
+	if m.notifier == nil || m.interval == 0 {
+		return nil
+	}
+	prs := make([]github.PullRequest, 0, len(results.Data.Search.Edges))
+	for _, edge := range results.Data.Search.Edges {
+		prs = append(prs, edge.Node)
+	}
+	current := snapshot{toggles: m.toggles(), prs: prs}
+	previous, present := m.snapshots[idx]
+	m.snapshots[idx] = current
+	if !present || previous.toggles != current.toggles {
+		return nil
+	}
+	name := m.tabs[idx].name
+	removed := notify.Removed(previous.prs, current.prs)
+	clients := map[string]github.Client{}
+	for _, pr := range removed {
+		clients[pr.Host] = m.clientFor(pr.Host)
+	}
+	return func() tea.Msg {
+		ctx := m.ctx
+		notifications := notify.Diff(previous.prs, current.prs)
+		notifications = append(notifications, removedNotifications(ctx, clients, removed)...)
+		return notifyMsg{err: m.notifier.Notify(ctx, name, notifications)}
+	}
+}
+
+// removedNotifications looks up the pull requests that left a tab to report
+// those that were merged, which closed pull requests leave most tabs.
+func removedNotifications(ctx context.Context, clients map[string]github.Client, removed []github.PullRequest) []notify.Notification {
+	notifications := []notify.Notification{}
+	for host, client := range clients {
+		before := map[string]github.PullRequest{}
+		ids := []string{}
+		for _, pr := range removed {
+			if pr.Host == host {
+				before[pr.ID] = pr
+				ids = append(ids, pr.ID)
+			}
+		}
+		response := github.GetPullRequests(ctx, client, ids)
+		if response.IsError() {
+			continue
+		}
+		for _, pr := range response.MustGet() {
+			if pr.State == "MERGED" && before[pr.ID].State != "MERGED" {
+				notifications = append(notifications, notify.Notification{Event: notify.Merged, PR: pr})
+			}
+		}
+	}
+	return notifications
+}
+
+func (m *Model) handleNotify(msg notifyMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.error = "notify failed: " + msg.err.Error()
+	}
+	return m, nil
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"context"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/state"
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// pinMsg reports that a pull request was pinned or unpinned.
+type pinMsg struct {
+	pr  github.PullRequest
+	pin *state.Pin
+	err error
+}
+
+// togglePin pins the selected pull request, or unpins it when it is pinned.
+func (m *Model) togglePin() tea.Cmd {
+	if m.store == nil {
+		m.error = "pin failed: no state database"
+		return nil
+	}
+	pr, ok := m.currentTable().SelectedPullRequest()
+	if !ok {
+		return nil
+	}
+	store := m.store
+	if _, present := m.pinned[pr.URL]; present {
+		return func() tea.Msg {
+			return pinMsg{pr: pr, err: store.Unpin(pr.URL)}
+		}
+	}
+	return func() tea.Msg {
+		pin, err := store.Pin(pr)
+		return pinMsg{pr: pr, pin: &pin, err: err}
+	}
+}
+
+// handlePin records the outcome of a pin in every tab. A newly pinned pull
+// request is added to the tabs whose search does not find it.
+func (m *Model) handlePin(msg pinMsg) {
+	if msg.err != nil {
+		m.error = "pin failed: " + msg.err.Error()
+		return
+	}
+	if msg.pin == nil {
+		delete(m.pinned, msg.pr.URL)
+	} else {
+		m.pinned[msg.pr.URL] = *msg.pin
+	}
+	for _, t := range m.tabs {
+		t.table.SetPinned(m.pinned)
+		if msg.pin != nil {
+			t.table.AddPullRequest(msg.pr)
+		}
+	}
+}
+
+// fetchPinned looks up the pinned pull requests of the given host that are
+// missing from the given search results, by node ID, so that they are shown
+// whatever the search finds.
+func fetchPinned(ctx context.Context, host Host, pinned map[string]state.Pin, results github.PullRequestSearchResults) result.Result[[]github.PullRequest] {
+	found := map[string]bool{}
+	for _, edge := range results.Data.Search.Edges {
+		found[edge.Node.URL] = true
+	}
+	ids := []string{}
+	for _, pin := range pinned {
+		if pin.Host == host.Name && !found[pin.URL] {
+			ids = append(ids, pin.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return result.Ok([]github.PullRequest{})
+	}
+	slices.Sort(ids)
+	return github.GetPullRequests(ctx, host.Client, ids)
+}
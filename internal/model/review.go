@@ -0,0 +1,85 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// reviewTitles names the review events for the review form heading.
+var reviewTitles = map[github.ReviewEvent]string{
+	github.Approve:        "Approve",
+	github.RequestChanges: "Request changes on",
+	github.Comment:        "Comment on",
+}
+
+func newReviewInput() textarea.Model {
+	input := textarea.New()
+	input.Placeholder = "Review body"
+	input.ShowLineNumbers = false
+	input.CharLimit = 0
+	return input
+}
+
+// startReview opens the review form for the given event on the selected pull
+// request.
+func (m *Model) startReview(event github.ReviewEvent) tea.Cmd {
+	pr, ok := m.currentTable().SelectedPullRequest()
+	if !ok {
+		return nil
+	}
+	m.reviewing = true
+	m.reviewEvent = event
+	m.reviewPR = pr
+	m.reviewInput.Reset()
+	return m.reviewInput.Focus()
+}
+
+// handleReviewKey handles keys while the review form is open. The submit key
+// submits the review and the cancel key discards it. Reviews other than
+// approvals need a body, so the form stays open when it is empty.
+func (m *Model) handleReviewKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.SubmitReview):
+		if m.reviewEvent != github.Approve && strings.TrimSpace(m.reviewInput.Value()) == "" {
+			m.error = "review failed: a body is required unless approving"
+			return m, nil
+		}
+		m.error = ""
+		m.reviewing = false
+		m.reviewInput.Blur()
+		return m, m.submitReview(m.reviewPR, m.reviewEvent, m.reviewInput.Value())
+	case key.Matches(msg, m.keys.CancelReview):
+		m.reviewing = false
+		m.reviewInput.Blur()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.reviewInput, cmd = m.reviewInput.Update(msg)
+	return m, cmd
+}
+
+// submitReview returns a command adding the review to the given pull request.
+func (m *Model) submitReview(pr github.PullRequest, event github.ReviewEvent, body string) tea.Cmd {
+	return m.runAction("review", pr, func(ctx context.Context, client github.Client) result.Result[github.PullRequest] {
+		return github.AddReview(ctx, client, pr.ID, event, body)
+	})
+}
+
+// reviewView renders the review form in place of the table.
+func (m *Model) reviewView() string {
+	heading := fmt.Sprintf("%s %s#%d: %s",
+		reviewTitles[m.reviewEvent],
+		m.reviewPR.Repository.NameWithOwner,
+		m.reviewPR.Number,
+		m.reviewPR.Title)
+	heading = lipgloss.NewStyle().Bold(true).MaxWidth(m.tableWidth).Render(heading)
+	return lipgloss.JoinVertical(lipgloss.Left, heading, "", m.reviewInput.View())
+}
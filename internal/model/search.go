@@ -0,0 +1,173 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/state"
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// defaultConcurrency is the number of queries run in parallel when none is
+// configured.
+const defaultConcurrency = 4
+
+// Update the model with search results, along with the pinned pull requests
+// that the search did not find.
+type searchResultsMsg struct {
+	selectedTab        TabIndex
+	searchResults      result.Result[github.PullRequestSearchResults]
+	pinned             []github.PullRequest
+	failedRepositories []string
+}
+
+// searchJob is a single query run against one host.
+type searchJob struct {
+	host    Host
+	label   string
+	options []github.Option
+}
+
+// fetch runs the query of the given tab against every configured host and
+// merges the results in host order. When the tab is scoped, or individual
+// repository queries are enabled, the query is restricted to the repositories
+// of each host. Individual repository queries are run once per repository. At
+// most m.concurrency queries are in flight at once. The given pinned pull
+// requests that no query found are then looked up by node ID and returned
+// apart from the results, so that they count neither as search results nor
+// towards the total. Queries that fail are reported in the returned message
+// while the results of the others are kept. An error is returned only when
+// there is nothing to query or every query fails.
+func (m *Model) fetch(idx TabIndex, pinned map[string]state.Pin) tea.Msg {
+	jobs := m.searchJobs(m.tabs[idx])
+	if len(jobs) == 0 {
+		return searchResultsMsg{selectedTab: idx, searchResults: result.Error[github.PullRequestSearchResults](errors.New("no repositories to query"))}
+	}
+	responses := make([]result.Result[github.PullRequestSearchResults], len(jobs))
+	semaphore := make(chan struct{}, max(m.concurrency, 1))
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			responses[i] = github.ExecuteQuery(m.ctx, job.host.Client, m.limit, job.options...)
+		}()
+	}
+	wg.Wait()
+	results := github.PullRequestSearchResults{}
+	failed := []string{}
+	var err error
+	for i, response := range responses {
+		if response.IsError() {
+			failed = append(failed, jobs[i].label)
+			if err == nil {
+				err = response.Error()
+			}
+			continue
+		}
+		results = mergeResults(results, withHost(jobs[i].host.Name, response.MustGet()))
+	}
+	if len(failed) != 0 && len(failed) == len(jobs) {
+		return searchResultsMsg{selectedTab: idx, searchResults: result.Error[github.PullRequestSearchResults](err)}
+	}
+	pinnedPRs := []github.PullRequest{}
+	for _, host := range m.hosts {
+		response := fetchPinned(m.ctx, host, pinned, results)
+		if response.IsError() {
+			failed = append(failed, host.Name+" pins")
+			continue
+		}
+		for _, pr := range response.MustGet() {
+			pr.Host = host.Name
+			pinnedPRs = append(pinnedPRs, pr)
+		}
+	}
+	return searchResultsMsg{selectedTab: idx, searchResults: result.Ok(results), pinned: pinnedPRs, failedRepositories: failed}
+}
+
+// searchJobs returns the queries needed to run the query of the given tab
+// against every configured host. Jobs are labelled with their repository,
+// prefixed with the host when more than one host is configured. Hosts that
+// none of the repositories of the tab belong to are skipped, and so are hosts
+// without repositories when the tab is scoped, rather than searching every
+// pull request.
+func (m *Model) searchJobs(t *tab) []searchJob {
+	options := slices.Concat(t.options, []github.Option{github.WithClosed(m.includeClosed), github.WithDrafts(m.includeDrafts)})
+	scoped := t.scoped || len(t.repositories) != 0
+	jobs := []searchJob{}
+	for _, host := range m.hosts {
+		repositories := host.Repositories
+		if len(t.repositories) != 0 {
+			repositories = repositoriesFor(host.Name, t.repositories)
+		}
+		if (scoped || m.individualRepoQuery) && len(repositories) == 0 {
+			continue
+		}
+		prefix := ""
+		if len(m.hosts) > 1 {
+			prefix = host.Name + ":"
+		}
+		switch {
+		case m.individualRepoQuery:
+			for _, repo := range repositories {
+				jobs = append(jobs, searchJob{
+					host:    host,
+					label:   prefix + repo,
+					options: append([]github.Option{github.ForRepositories([]string{repo})}, options...),
+				})
+			}
+		case scoped:
+			jobs = append(jobs, searchJob{
+				host:    host,
+				label:   host.Name,
+				options: append([]github.Option{github.ForRepositories(repositories)}, options...),
+			})
+		default:
+			jobs = append(jobs, searchJob{
+				host:    host,
+				label:   host.Name,
+				options: options,
+			})
+		}
+	}
+	return jobs
+}
+
+// repositoriesFor returns the repositories that apply to the given host.
+// Repositories named "owner/repo" apply to every host while those named
+// "host/owner/repo" only apply to their host and are returned without it.
+func repositoriesFor(host string, repositories []string) []string {
+	selected := []string{}
+	for _, repo := range repositories {
+		parts := strings.SplitN(repo, "/", 3)
+		switch {
+		case len(parts) < 3:
+			selected = append(selected, repo)
+		case parts[0] == host:
+			selected = append(selected, parts[1]+"/"+parts[2])
+		}
+	}
+	return selected
+}
+
+// withHost records the host that was queried on every pull request in the
+// given results.
+func withHost(host string, results github.PullRequestSearchResults) github.PullRequestSearchResults {
+	for i := range results.Data.Search.Edges {
+		results.Data.Search.Edges[i].Node.Host = host
+	}
+	return results
+}
+
+func mergeResults(acc github.PullRequestSearchResults, newResults github.PullRequestSearchResults) github.PullRequestSearchResults {
+	acc.Data.Search.Edges = append(acc.Data.Search.Edges, newResults.Data.Search.Edges...)
+	acc.Data.RateLimit = acc.Data.RateLimit.Merge(newResults.Data.RateLimit)
+	acc.Data.Search.IssueCount += newResults.Data.Search.IssueCount
+	return acc
+}
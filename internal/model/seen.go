@@ -0,0 +1,76 @@
+package model
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/state"
+)
+
+// seenMsg reports that a view of a pull request was recorded.
+type seenMsg struct {
+	url    string
+	record state.Seen
+	err    error
+}
+
+// moved returns true when the given key moves the cursor of the PR list.
+func (m *Model) moved(msg tea.KeyMsg) bool {
+	return key.Matches(msg, m.keys.LineUp, m.keys.LineDown, m.keys.PageUp, m.keys.PageDown, m.keys.GotoTop, m.keys.GotoBottom)
+}
+
+// listFocused returns true when keys go to the PR list rather than to a
+// dialog or an overlay.
+func (m *Model) listFocused() bool {
+	return !m.filtering && !m.reviewing && m.confirmation == nil && m.prompt == nil &&
+		!m.showBulk && !m.showChecks && !m.showHelp
+}
+
+// syncSeen gives the details pane what was seen of the pull request under the
+// cursor when the cursor lands on another pull request, so that it can tell
+// what is new. When viewed is true it returns a command recording that the
+// user looked at that pull request.
+func (m *Model) syncSeen(viewed bool) tea.Cmd {
+	if m.store == nil {
+		return nil
+	}
+	pr, ok := m.currentTable().SelectedPullRequest()
+	if !ok {
+		return nil
+	}
+	previous, present := m.seen[pr.URL]
+	if pr.URL != m.seenURL {
+		m.seenURL = pr.URL
+		if present {
+			m.details.SetLastSeen(&previous, previous.NewComments(pr))
+		} else {
+			m.details.SetLastSeen(nil, 0)
+		}
+	}
+	if !viewed || (present && previous.Matches(pr)) {
+		return nil
+	}
+	return m.markSeen(pr)
+}
+
+// markSeen returns a command recording that the user looked at the given pull
+// request.
+func (m *Model) markSeen(pr github.PullRequest) tea.Cmd {
+	store := m.store
+	return func() tea.Msg {
+		record, err := store.MarkSeen(pr)
+		return seenMsg{url: pr.URL, record: record, err: err}
+	}
+}
+
+// handleSeen records a view of a pull request in every tab.
+func (m *Model) handleSeen(msg seenMsg) {
+	if msg.err != nil {
+		m.error = "failed to save state: " + msg.err.Error()
+		return
+	}
+	m.seen[msg.url] = msg.record
+	for _, t := range m.tabs {
+		t.table.SetSeen(m.seen)
+	}
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/state"
+)
+
+// snoozeMsg reports that a pull request was snoozed or brought back.
+type snoozeMsg struct {
+	url    string
+	snooze *state.Snooze
+	err    error
+}
+
+// startSnooze asks how long to snooze the selected pull request or, when it
+// is snoozed already, whether to bring it back.
+func (m *Model) startSnooze() {
+	if m.store == nil {
+		m.error = "snooze failed: no state database"
+		return
+	}
+	pr, ok := m.currentTable().SelectedPullRequest()
+	if !ok {
+		return
+	}
+	if m.currentTable().IsSnoozed(pr) {
+		m.confirm("Wake "+describe(pr)+"?", choice{key: "y", label: "yes", cmd: m.unsnooze(pr)})
+		return
+	}
+	m.confirm("Snooze "+describe(pr)+"?",
+		choice{key: "u", label: "until updated", cmd: m.snooze(pr, time.Time{})},
+		choice{key: "d", label: "until a date or duration", cmd: prompt("Snooze until (YYYY-MM-DD or 3d, 2w, 12h):", func(value string) tea.Cmd {
+			until, err := parseSnoozeUntil(value, time.Now())
+			if err != nil {
+				return func() tea.Msg { return snoozeMsg{url: pr.URL, err: err} }
+			}
+			return m.snooze(pr, until)
+		})},
+	)
+}
+
+// snooze returns a command snoozing the given pull request until it is updated
+// or, when until is not zero, until that time.
+func (m *Model) snooze(pr github.PullRequest, until time.Time) tea.Cmd {
+	store := m.store
+	return func() tea.Msg {
+		snooze, err := store.Snooze(pr, until)
+		return snoozeMsg{url: pr.URL, snooze: &snooze, err: err}
+	}
+}
+
+// unsnooze returns a command bringing back the given pull request.
+func (m *Model) unsnooze(pr github.PullRequest) tea.Cmd {
+	store := m.store
+	return func() tea.Msg {
+		return snoozeMsg{url: pr.URL, err: store.Unsnooze(pr.URL)}
+	}
+}
+
+// handleSnooze records the outcome of a snooze in every tab.
+func (m *Model) handleSnooze(msg snoozeMsg) {
+	if msg.err != nil {
+		m.error = "snooze failed: " + msg.err.Error()
+		return
+	}
+	if msg.snooze == nil {
+		delete(m.snoozed, msg.url)
+	} else {
+		m.snoozed[msg.url] = *msg.snooze
+	}
+	for _, t := range m.tabs {
+		t.table.SetSnoozed(m.snoozed)
+	}
+}
+
+// parseSnoozeUntil parses the end of a snooze given either as a date, which
+// ends at the start of that day, or as a duration from now. Durations are
+// Go durations such as 12h or a number of days or weeks such as 3d or 2w.
+func parseSnoozeUntil(value string, now time.Time) (time.Time, error) {
+	if date, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		if !date.After(now) {
+			return time.Time{}, fmt.Errorf("%s is not in the future", value)
+		}
+		return date, nil
+	}
+	for suffix, days := range map[string]int{"d": 1, "w": 7} {
+		count, found := strings.CutSuffix(value, suffix)
+		if !found {
+			continue
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil || n <= 0 {
+			return time.Time{}, fmt.Errorf("invalid duration %q", value)
+		}
+		return now.AddDate(0, 0, n*days), nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return time.Time{}, fmt.Errorf("invalid date or duration %q", value)
+	}
+	return now.Add(duration), nil
+}
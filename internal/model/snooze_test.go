@@ -0,0 +1,45 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSnoozeUntil(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "2024-03-12", want: time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC)},
+		{value: "2024-03-10", wantErr: true},
+		{value: "2024-01-01", wantErr: true},
+		{value: "3d", want: now.AddDate(0, 0, 3)},
+		{value: "2w", want: now.AddDate(0, 0, 14)},
+		{value: "12h", want: now.Add(12 * time.Hour)},
+		{value: "90m", want: now.Add(90 * time.Minute)},
+		{value: "0d", wantErr: true},
+		{value: "-1w", wantErr: true},
+		{value: "xd", wantErr: true},
+		{value: "-2h", wantErr: true},
+		{value: "tomorrow", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSnoozeUntil(tt.value, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseSnoozeUntil(%q) = %v, want an error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSnoozeUntil(%q) error = %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseSnoozeUntil(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
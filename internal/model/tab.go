@@ -0,0 +1,90 @@
+package model
+
+import (
+	"maps"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/prtable"
+)
+
+// TabOptions configures a user defined tab backed by a saved search query.
+type TabOptions struct {
+	Name         string
+	Query        string
+	Repositories []string
+	DefaultView  []prtable.Column
+	WideView     []prtable.Column
+	DefaultSort  *prtable.Sort
+	WideSort     *prtable.Sort
+	GroupBy      *prtable.GroupBy
+}
+
+// tab is a view in the top tab bar backed by a search query.
+type tab struct {
+	name string
+	// options build the search query of this tab.
+	options []github.Option
+	// scoped restricts the query to the repositories of each host.
+	scoped bool
+	// repositories replace the repositories of each host when set.
+	repositories []string
+	view         prtable.Options
+	table        *prtable.PRTable
+}
+
+// BuiltinTabNames are the names of the built in tabs, in TabIndex order.
+var BuiltinTabNames = []string{"My PRs", "My Requests", "All PRs"}
+
+// newTabs returns the built in tabs, in TabIndex order, followed by the user
+// defined tabs. Tabs without their own views, sort orders or grouping use the
+// default ones.
+func (m *Model) newTabs(opts Options) []*tab {
+	view := prtable.Options{GroupBy: opts.GroupBy, Keys: &m.keys}
+	tabs := []*tab{
+		{name: BuiltinTabNames[MyPRsTab], options: []github.Option{github.ForMyPRs}, view: view},
+		{name: BuiltinTabNames[MyRequestsTab], options: []github.Option{github.ForMyRequests}, view: view},
+		{name: BuiltinTabNames[AllPRsTab], scoped: true, view: view},
+	}
+	for _, tabOpts := range opts.Tabs {
+		groupBy := opts.GroupBy
+		if tabOpts.GroupBy != nil {
+			groupBy = *tabOpts.GroupBy
+		}
+		tabs = append(tabs, &tab{
+			name:         tabOpts.Name,
+			options:      []github.Option{github.ForQuery(tabOpts.Query)},
+			repositories: tabOpts.Repositories,
+			view: prtable.Options{
+				DefaultColumns: tabOpts.DefaultView,
+				WideColumns:    tabOpts.WideView,
+				DefaultSort:    tabOpts.DefaultSort,
+				WideSort:       tabOpts.WideSort,
+				GroupBy:        groupBy,
+				Keys:           &m.keys,
+			},
+		})
+	}
+	for i, t := range tabs {
+		idx := TabIndex(i)
+		if len(t.view.DefaultColumns) == 0 {
+			t.view.DefaultColumns = opts.DefaultView
+		}
+		if len(t.view.WideColumns) == 0 {
+			t.view.WideColumns = opts.WideView
+		}
+		if t.view.DefaultSort == nil {
+			t.view.DefaultSort = opts.DefaultSort
+		}
+		if t.view.WideSort == nil {
+			t.view.WideSort = opts.WideSort
+		}
+		t.table = prtable.New(func() tea.Cmd {
+			// The pins are copied here since handlePin changes them while
+			// the fetch runs.
+			pinned := maps.Clone(m.pinned)
+			return func() tea.Msg { return m.fetch(idx, pinned) }
+		}, t.view)
+	}
+	return tabs
+}
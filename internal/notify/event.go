@@ -0,0 +1,174 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mrxk/gh-my/internal/github"
+)
+
+// Event identifies a change to a pull request worth a notification.
+type Event int
+
+const (
+	NewPullRequest Event = iota
+	ChecksFailed
+	ChecksPassed
+	Approved
+	ChangesRequested
+	Merged
+	NewComments
+	Conflicting
+)
+
+var (
+	event_name = map[Event]string{
+		NewPullRequest:   "new",
+		ChecksFailed:     "checksFailed",
+		ChecksPassed:     "checksPassed",
+		Approved:         "approved",
+		ChangesRequested: "changesRequested",
+		Merged:           "merged",
+		NewComments:      "comments",
+		Conflicting:      "conflicting",
+	}
+	event_value = map[string]Event{
+		"new":              NewPullRequest,
+		"checksFailed":     ChecksFailed,
+		"checksPassed":     ChecksPassed,
+		"approved":         Approved,
+		"changesRequested": ChangesRequested,
+		"merged":           Merged,
+		"comments":         NewComments,
+		"conflicting":      Conflicting,
+	}
+	event_title = map[Event]string{
+		NewPullRequest:   "New PR",
+		ChecksFailed:     "Checks failed",
+		ChecksPassed:     "Checks passed",
+		Approved:         "Approved",
+		ChangesRequested: "Changes requested",
+		Merged:           "Merged",
+		NewComments:      "New comments",
+		Conflicting:      "Conflicting",
+	}
+)
+
+func (e Event) String() string {
+	return event_name[e]
+}
+
+func parseEvent(s string) (Event, error) {
+	s = strings.TrimSpace(s)
+	for name, value := range event_value {
+		if strings.EqualFold(name, s) {
+			return value, nil
+		}
+	}
+	names := slices.Sorted(maps.Keys(event_value))
+	return 0, fmt.Errorf("unknown event: %s (must be one of %s)", s, strings.Join(names, ", "))
+}
+
+func (e *Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	*e, err = parseEvent(s)
+	return err
+}
+
+// Notification is an event that happened to a pull request.
+type Notification struct {
+	Event Event
+	PR    github.PullRequest
+}
+
+// Title returns the summary of the notification.
+func (n Notification) Title() string {
+	return event_title[n.Event]
+}
+
+// Body returns the pull request the notification is about.
+func (n Notification) Body() string {
+	return fmt.Sprintf("%s#%d: %s", n.PR.Repository.NameWithOwner, n.PR.Number, n.PR.Title)
+}
+
+// Diff returns the events that turn the previous snapshot of a tab into the
+// current one. Pull requests that are no longer listed are not reported here
+// since they left the tab for reasons that the snapshots do not tell.
+func Diff(previous []github.PullRequest, current []github.PullRequest) []Notification {
+	byID := map[string]github.PullRequest{}
+	for _, pr := range previous {
+		byID[pr.ID] = pr
+	}
+	notifications := []Notification{}
+	for _, pr := range current {
+		before, present := byID[pr.ID]
+		if !present {
+			notifications = append(notifications, Notification{Event: NewPullRequest, PR: pr})
+			continue
+		}
+		for _, event := range Changes(before, pr) {
+			notifications = append(notifications, Notification{Event: event, PR: pr})
+		}
+	}
+	return notifications
+}
+
+// Changes returns the events that happened between two snapshots of the same
+// pull request.
+func Changes(before github.PullRequest, after github.PullRequest) []Event {
+	events := []Event{}
+	checks := after.StatusCheckRollup.State
+	if checks != before.StatusCheckRollup.State {
+		switch checks {
+		case "FAILURE", "ERROR":
+			events = append(events, ChecksFailed)
+		case "SUCCESS":
+			events = append(events, ChecksPassed)
+		}
+	}
+	if after.ReviewDecision != before.ReviewDecision {
+		switch after.ReviewDecision {
+		case "APPROVED":
+			events = append(events, Approved)
+		case "CHANGES_REQUESTED":
+			events = append(events, ChangesRequested)
+		}
+	}
+	if after.State == "MERGED" && before.State != "MERGED" {
+		events = append(events, Merged)
+	}
+	if after.TotalCommentsCount > before.TotalCommentsCount {
+		events = append(events, NewComments)
+	}
+	if after.Mergeable == "CONFLICTING" && before.Mergeable != "CONFLICTING" {
+		events = append(events, Conflicting)
+	}
+	return events
+}
+
+// Removed returns the pull requests of the previous snapshot that are not in
+// the current one.
+func Removed(previous []github.PullRequest, current []github.PullRequest) []github.PullRequest {
+	ids := map[string]bool{}
+	for _, pr := range current {
+		ids[pr.ID] = true
+	}
+	removed := []github.PullRequest{}
+	for _, pr := range previous {
+		if !ids[pr.ID] {
+			removed = append(removed, pr)
+		}
+	}
+	return removed
+}
@@ -0,0 +1,118 @@
+package notify
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/mrxk/gh-my/internal/github"
+)
+
+// pullRequest returns an open, mergeable pull request with the given ID and
+// passing checks, changed by the given functions.
+func pullRequest(id string, changes ...func(*github.PullRequest)) github.PullRequest {
+	pr := github.PullRequest{ID: id, State: "OPEN", Mergeable: "MERGEABLE"}
+	pr.StatusCheckRollup.State = "SUCCESS"
+	for _, change := range changes {
+		change(&pr)
+	}
+	return pr
+}
+
+func checks(state string) func(*github.PullRequest) {
+	return func(pr *github.PullRequest) { pr.StatusCheckRollup.State = state }
+}
+
+func review(decision string) func(*github.PullRequest) {
+	return func(pr *github.PullRequest) { pr.ReviewDecision = decision }
+}
+
+func comments(count int) func(*github.PullRequest) {
+	return func(pr *github.PullRequest) { pr.TotalCommentsCount = count }
+}
+
+func state(value string) func(*github.PullRequest) {
+	return func(pr *github.PullRequest) { pr.State = value }
+}
+
+func mergeable(value string) func(*github.PullRequest) {
+	return func(pr *github.PullRequest) { pr.Mergeable = value }
+}
+
+func TestChanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		before github.PullRequest
+		after  github.PullRequest
+		want   []Event
+	}{
+		{name: "unchanged", before: pullRequest("a"), after: pullRequest("a"), want: []Event{}},
+		{name: "checks failed", before: pullRequest("a"), after: pullRequest("a", checks("FAILURE")), want: []Event{ChecksFailed}},
+		{name: "checks errored", before: pullRequest("a", checks("PENDING")), after: pullRequest("a", checks("ERROR")), want: []Event{ChecksFailed}},
+		{name: "checks passed", before: pullRequest("a", checks("PENDING")), after: pullRequest("a"), want: []Event{ChecksPassed}},
+		{name: "checks started", before: pullRequest("a"), after: pullRequest("a", checks("PENDING")), want: []Event{}},
+		{name: "approved", before: pullRequest("a"), after: pullRequest("a", review("APPROVED")), want: []Event{Approved}},
+		{name: "changes requested", before: pullRequest("a", review("APPROVED")), after: pullRequest("a", review("CHANGES_REQUESTED")), want: []Event{ChangesRequested}},
+		{name: "review dismissed", before: pullRequest("a", review("APPROVED")), after: pullRequest("a", review("REVIEW_REQUIRED")), want: []Event{}},
+		{name: "merged", before: pullRequest("a"), after: pullRequest("a", state("MERGED")), want: []Event{Merged}},
+		{name: "new comments", before: pullRequest("a", comments(1)), after: pullRequest("a", comments(3)), want: []Event{NewComments}},
+		{name: "deleted comments", before: pullRequest("a", comments(3)), after: pullRequest("a", comments(1)), want: []Event{}},
+		{name: "conflicting", before: pullRequest("a"), after: pullRequest("a", mergeable("CONFLICTING")), want: []Event{Conflicting}},
+		{name: "still conflicting", before: pullRequest("a", mergeable("CONFLICTING")), after: pullRequest("a", mergeable("CONFLICTING")), want: []Event{}},
+		{
+			name:   "several changes",
+			before: pullRequest("a", checks("PENDING")),
+			after:  pullRequest("a", checks("FAILURE"), review("APPROVED"), comments(2)),
+			want:   []Event{ChecksFailed, Approved, NewComments},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Changes(tt.before, tt.after); !slices.Equal(got, tt.want) {
+				t.Errorf("Changes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	type notification struct {
+		event Event
+		id    string
+	}
+	tests := []struct {
+		name     string
+		previous []github.PullRequest
+		current  []github.PullRequest
+		want     []notification
+	}{
+		{name: "empty", want: []notification{}},
+		{
+			name:    "new pull requests",
+			current: []github.PullRequest{pullRequest("a"), pullRequest("b")},
+			want:    []notification{{NewPullRequest, "a"}, {NewPullRequest, "b"}},
+		},
+		{
+			name:     "removed pull requests are not reported",
+			previous: []github.PullRequest{pullRequest("a"), pullRequest("b")},
+			current:  []github.PullRequest{pullRequest("b")},
+			want:     []notification{},
+		},
+		{
+			name:     "changed pull requests",
+			previous: []github.PullRequest{pullRequest("a"), pullRequest("b")},
+			current:  []github.PullRequest{pullRequest("b", state("MERGED")), pullRequest("a", checks("FAILURE")), pullRequest("c")},
+			want:     []notification{{Merged, "b"}, {ChecksFailed, "a"}, {NewPullRequest, "c"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []notification{}
+			for _, n := range Diff(tt.previous, tt.current) {
+				got = append(got, notification{n.Event, n.PR.ID})
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Package notify turns changes between successive search results into desktop
+// notifications.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"slices"
+)
+
+// DefaultCommand is the command run for each notification when none is
+// configured. The title and body of the notification are appended to it.
+var DefaultCommand = []string{"notify-send", "--app-name=gh my"}
+
+// Rule selects the events that notify for the tab with the given name.
+type Rule struct {
+	Tab    string  `json:"tab"`
+	Events []Event `json:"events"`
+}
+
+// Options configures the command run for notifications and the rules
+// selecting which events notify on which tabs. Without rules every event
+// notifies on every tab.
+type Options struct {
+	Command []string `json:"command,omitempty"`
+	Rules   []Rule   `json:"rules,omitempty"`
+}
+
+// Notifier sends the notifications that its rules allow.
+type Notifier struct {
+	command []string
+	rules   []Rule
+}
+
+func New(opts Options) *Notifier {
+	command := opts.Command
+	if len(command) == 0 {
+		command = DefaultCommand
+	}
+	return &Notifier{command: command, rules: opts.Rules}
+}
+
+// Enabled returns true when the given event notifies on the named tab.
+func (n *Notifier) Enabled(tab string, event Event) bool {
+	if len(n.rules) == 0 {
+		return true
+	}
+	for _, rule := range n.rules {
+		if rule.Tab == tab && slices.Contains(rule.Events, event) {
+			return true
+		}
+	}
+	return false
+}
+
+// Notify runs the notification command for each of the given notifications
+// that is enabled on the named tab. The title of a notification is prefixed
+// with the tab name. The first error, if any, is returned once every
+// notification was attempted.
+func (n *Notifier) Notify(ctx context.Context, tab string, notifications []Notification) error {
+	var err error
+	for _, notification := range notifications {
+		if !n.Enabled(tab, notification.Event) {
+			continue
+		}
+		args := append(slices.Clone(n.command[1:]), tab+": "+notification.Title(), notification.Body())
+		runErr := exec.CommandContext(ctx, n.command[0], args...).Run()
+		if runErr != nil && err == nil {
+			err = fmt.Errorf("%s: %w", n.command[0], runErr)
+		}
+	}
+	return err
+}
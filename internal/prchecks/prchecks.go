@@ -0,0 +1,118 @@
+package prchecks
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/prtable"
+	"github.com/mrxk/gh-my/internal/theme"
+)
+
+// Checks lists the individual checks of a pull request's status check
+// rollup.
+type Checks struct {
+	table.Model
+	title  string
+	checks []github.CheckContext
+}
+
+var titleStyle = lipgloss.NewStyle().Bold(true)
+
+// New returns an empty checks view navigated with the given keys.
+func New(keyMap table.KeyMap) *Checks {
+	return &Checks{
+		Model: table.New(
+			table.WithKeyMap(keyMap),
+			table.WithStyles(theme.Current().TableStyles()),
+			table.WithColumns(columns(0)),
+		),
+	}
+}
+
+// SetPullRequest lists the checks of the given pull request.
+func (c *Checks) SetPullRequest(pr github.PullRequest) {
+	c.title = fmt.Sprintf("Checks of #%d %s", pr.Number, pr.Title)
+	c.checks = pr.StatusCheckRollup.Contexts.Nodes
+	rows := make([]table.Row, 0, len(c.checks))
+	for _, check := range c.checks {
+		rows = append(rows, table.Row{
+			prtable.CheckEmoji(check.Outcome()),
+			check.DisplayName(),
+			result(check),
+			check.Link(),
+		})
+	}
+	c.Model.SetRows(rows)
+	c.Model.SetCursor(0)
+}
+
+// SetSize sets the size of the list, including its title line.
+func (c *Checks) SetSize(width, height int) {
+	c.Model.SetWidth(width)
+	c.Model.SetHeight(height - 1)
+	c.Model.SetColumns(columns(width))
+}
+
+// SelectedURL returns the details URL of the check under the cursor.
+func (c *Checks) SelectedURL() string {
+	row := c.Cursor()
+	if row >= 0 && row < len(c.checks) {
+		return c.checks[row].Link()
+	}
+	return ""
+}
+
+// Status returns a summary for the footer.
+func (c *Checks) Status() string {
+	return fmt.Sprintf("%d checks, %d failing", len(c.checks), countFailing(c.checks))
+}
+
+// Update implements tea.Model.
+func (c *Checks) Update(msg tea.Msg) (*Checks, tea.Cmd) {
+	var cmd tea.Cmd
+	c.Model, cmd = c.Model.Update(msg)
+	return c, cmd
+}
+
+// View implements tea.Model.
+func (c *Checks) View() string {
+	return lipgloss.JoinVertical(lipgloss.Left, titleStyle.Render(c.title), c.Model.View())
+}
+
+// columns returns the table columns for the given width, giving the name and
+// details URL what is left after the fixed width columns.
+func columns(width int) []table.Column {
+	flexible := max(width-2-10-4*2, 20)
+	return []table.Column{
+		{Title: " ", Width: 2},
+		{Title: "Check", Width: flexible / 2},
+		{Title: "Result", Width: 10},
+		{Title: "Details", Width: flexible - flexible/2},
+	}
+}
+
+// result returns the raw result of a check, which is more specific than its
+// outcome.
+func result(check github.CheckContext) string {
+	switch {
+	case check.Typename == "StatusContext":
+		return check.State
+	case check.Status != "COMPLETED":
+		return check.Status
+	default:
+		return check.Conclusion
+	}
+}
+
+func countFailing(checks []github.CheckContext) int {
+	count := 0
+	for _, check := range checks {
+		if check.Outcome() == "FAILURE" {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,201 @@
+package prdetails
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/glamour/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/cli/go-gh/pkg/text"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/prtable"
+	"github.com/mrxk/gh-my/internal/state"
+	"github.com/mrxk/gh-my/internal/theme"
+	"github.com/sassoftware/sas-ggdk/pkg/result"
+)
+
+// Pane shows the details of a single pull request.
+type Pane struct {
+	width     int
+	height    int
+	loading   bool
+	err       error
+	details   *github.PullRequestDetails
+	body      string
+	bodyID    string
+	bodyWidth int
+	lastSeen  string
+}
+
+var (
+	titleStyle = lipgloss.NewStyle().Bold(true)
+	labelStyle = lipgloss.NewStyle().Faint(true)
+)
+
+func New() *Pane {
+	return &Pane{}
+}
+
+// SetSize sets the outer size of the pane, including its border.
+func (p *Pane) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+}
+
+// Width returns the outer width of the pane.
+func (p *Pane) Width() int {
+	return p.width
+}
+
+// SetLoading marks the pane as waiting for details.
+func (p *Pane) SetLoading() {
+	p.loading = true
+	p.err = nil
+}
+
+// Clear removes any details from the pane.
+func (p *Pane) Clear() {
+	p.loading = false
+	p.err = nil
+	p.details = nil
+}
+
+// SetDetails shows the given details, or the error that occurred while
+// fetching them.
+func (p *Pane) SetDetails(details result.Result[github.PullRequestDetails]) {
+	p.loading = false
+	if details.IsError() {
+		p.err = details.Error()
+		p.details = nil
+		return
+	}
+	p.err = nil
+	value := details.MustGet()
+	p.details = &value
+}
+
+// SetLastSeen sets when the user last looked at the pull request, and how
+// many comments were added since. A nil snapshot means that the pull request
+// was never seen before.
+func (p *Pane) SetLastSeen(seen *state.Seen, newComments int) {
+	switch {
+	case seen == nil:
+		p.lastSeen = "never"
+	case newComments == 0:
+		p.lastSeen = prtable.TimeAgo(seen.SeenAt)
+	default:
+		p.lastSeen = fmt.Sprintf("%s, %s since", prtable.TimeAgo(seen.SeenAt), text.Pluralize(newComments, "new comment"))
+	}
+}
+
+// View renders the pane.
+func (p *Pane) View() string {
+	paneStyle := theme.Current().BorderStyle(false, false, false, true).PaddingLeft(1)
+	contentWidth := max(p.width-paneStyle.GetHorizontalFrameSize(), 1)
+	var content string
+	switch {
+	case p.loading:
+		content = "Loading ..."
+	case p.err != nil:
+		content = p.err.Error()
+	case p.details == nil:
+		content = "No pull request selected"
+	default:
+		content = p.detailsView(contentWidth)
+	}
+	return paneStyle.
+		Width(contentWidth).
+		Height(p.height).
+		MaxHeight(p.height).
+		Render(lipgloss.NewStyle().MaxWidth(contentWidth).Render(content))
+}
+
+func (p *Pane) detailsView(width int) string {
+	d := p.details
+	lines := []string{
+		titleStyle.Width(width).Render(fmt.Sprintf("#%d %s", d.Number, d.Title)),
+		labelStyle.Render("Branch:    ") + d.BaseRefName + " ← " + d.HeadRefName,
+	}
+	if p.lastSeen != "" {
+		lines = append(lines, labelStyle.Render("Seen:      ")+p.lastSeen)
+	}
+	labels := make([]string, 0, len(d.Labels.Nodes))
+	for _, label := range d.Labels.Nodes {
+		style := lipgloss.NewStyle().Foreground(lipgloss.Color("#" + label.Color))
+		labels = append(labels, style.Render(label.Name))
+	}
+	if len(labels) != 0 {
+		lines = append(lines, labelStyle.Render("Labels:    ")+strings.Join(labels, ", "))
+	}
+	assignees := make([]string, 0, len(d.Assignees.Nodes))
+	for _, assignee := range d.Assignees.Nodes {
+		assignees = append(assignees, assignee.Login)
+	}
+	if len(assignees) != 0 {
+		lines = append(lines, labelStyle.Render("Assignees: ")+strings.Join(assignees, ", "))
+	}
+	if reviewers := d.Reviewers(); len(reviewers) != 0 {
+		lines = append(lines, "", labelStyle.Render("Reviewers"))
+		for _, reviewer := range reviewers {
+			lines = append(lines, fmt.Sprintf("%s %s (%s)", reviewEmoji(reviewer.State), reviewer.Login, humanize(reviewer.State)))
+		}
+	}
+	if checks := d.StatusCheckRollup.Contexts.Nodes; len(checks) != 0 {
+		lines = append(lines, "", labelStyle.Render("Checks"))
+		for _, check := range checks {
+			glyph := theme.Current().Status(prtable.CheckOutcome(check.Outcome()), prtable.CheckEmoji(check.Outcome()))
+			lines = append(lines, fmt.Sprintf("%s %s", glyph, check.DisplayName()))
+		}
+	}
+	lines = append(lines, "", p.renderBody(width))
+	return strings.Join(lines, "\n")
+}
+
+// renderBody renders the markdown body of the pull request. The rendered body
+// is kept until another pull request is shown or the width changes.
+func (p *Pane) renderBody(width int) string {
+	key := p.details.ID + "@" + p.details.UpdatedAt
+	if p.bodyID == key && p.bodyWidth == width {
+		return p.body
+	}
+	p.bodyID = key
+	p.bodyWidth = width
+	p.body = p.details.Body
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle(styles.DarkStyle),
+		glamour.WithWordWrap(width),
+	)
+	if err != nil {
+		return p.body
+	}
+	rendered, err := renderer.Render(p.details.Body)
+	if err != nil {
+		return p.body
+	}
+	p.body = strings.Trim(rendered, "\n")
+	return p.body
+}
+
+// reviewEmoji returns the glyph of a review state, colored by its outcome.
+func reviewEmoji(state string) string {
+	t := theme.Current()
+	switch state {
+	case "APPROVED":
+		return t.Status(theme.Success, t.Glyphs.Approved)
+	case "CHANGES_REQUESTED":
+		return t.Status(theme.Failure, t.Glyphs.ChangesRequested)
+	case "COMMENTED":
+		return t.Glyphs.Commented
+	case "DISMISSED":
+		return t.Glyphs.Dismissed
+	default:
+		return t.Status(theme.Pending, t.Glyphs.Pending)
+	}
+}
+
+// humanize turns an enum value such as CHANGES_REQUESTED into "changes
+// requested".
+func humanize(value string) string {
+	return strings.ToLower(strings.ReplaceAll(value, "_", " "))
+}
@@ -0,0 +1,57 @@
+package prtable
+
+import (
+	"github.com/mrxk/gh-my/internal/github"
+)
+
+// trackChanges flags the pull requests of a newly loaded page that were not
+// loaded before, or whose checks, mergeability, review decision, comments or
+// last update differ from the loaded ones. Nothing is flagged on the first
+// page, nor on the first page after the query changed since it is compared
+// with the results of another query. The selected pull request is not
+// flagged since the user is looking at it. Flags are kept until the row is
+// selected.
+func (t *PRTable) trackChanges(current *page) *page {
+	if t.currentResults == nil || t.queryChanged {
+		t.queryChanged = false
+		return current
+	}
+	before := map[string]github.PullRequest{}
+	for _, pr := range t.currentResults.prs {
+		before[pr.ID] = pr
+	}
+	for _, pr := range current.prs {
+		old, present := before[pr.ID]
+		if pr.ID != t.selectedID && (!present || changed(old, pr)) {
+			t.changed[pr.ID] = true
+		}
+	}
+	return current
+}
+
+// changed returns true when the values shown in the checks, mergeable,
+// approved, comments or updatedAt columns differ between two snapshots of
+// the same pull request.
+func changed(a, b github.PullRequest) bool {
+	return a.StatusCheckRollup.State != b.StatusCheckRollup.State ||
+		a.Mergeable != b.Mergeable ||
+		a.MergeStateStatus != b.MergeStateStatus ||
+		a.ReviewDecision != b.ReviewDecision ||
+		a.TotalCommentsCount != b.TotalCommentsCount ||
+		a.UpdatedAt != b.UpdatedAt
+}
+
+// seeSelected clears the change flag of the pull request under the cursor
+// when the cursor moved onto it.
+func (t *PRTable) seeSelected() {
+	pr, ok := t.SelectedPullRequest()
+	if !ok || pr.ID == t.selectedID {
+		return
+	}
+	t.selectedID = pr.ID
+	if t.changed[pr.ID] {
+		delete(t.changed, pr.ID)
+		t.updateModel(t.currentResults)
+		t.Model.UpdateViewport()
+	}
+}
@@ -15,6 +15,7 @@ type Column int
 
 const (
 	checksColumn Column = iota
+	failingChecksColumn
 	mergeableColumn
 	approvedColumn
 	draftColumn
@@ -22,6 +23,7 @@ const (
 	urlColumn
 	authorColumn
 	repositoryColumn
+	hostColumn
 	changeColumn
 	stateColumn
 	commentsColumn
@@ -30,74 +32,84 @@ const (
 
 var (
 	columnIndex_name = map[Column]string{
-		checksColumn:     "checks",
-		mergeableColumn:  "mergable",
-		approvedColumn:   "approved",
-		draftColumn:      "draft",
-		titleColumn:      "title",
-		urlColumn:        "url",
-		authorColumn:     "author",
-		repositoryColumn: "repository",
-		changeColumn:     "change",
-		stateColumn:      "state",
-		commentsColumn:   "comments",
-		updatedAtColumn:  "updatedAt",
+		checksColumn:        "checks",
+		failingChecksColumn: "failingChecks",
+		mergeableColumn:     "mergeable",
+		approvedColumn:      "approved",
+		draftColumn:         "draft",
+		titleColumn:         "title",
+		urlColumn:           "url",
+		authorColumn:        "author",
+		repositoryColumn:    "repository",
+		hostColumn:          "host",
+		changeColumn:        "change",
+		stateColumn:         "state",
+		commentsColumn:      "comments",
+		updatedAtColumn:     "updatedAt",
 	}
 	column_value = map[string]Column{
-		"checks":     checksColumn,
-		"mergeable":  mergeableColumn,
-		"approved":   approvedColumn,
-		"draft":      draftColumn,
-		"title":      titleColumn,
-		"url":        urlColumn,
-		"author":     authorColumn,
-		"repository": repositoryColumn,
-		"change":     changeColumn,
-		"state":      stateColumn,
-		"comments":   commentsColumn,
-		"updatedAt":  updatedAtColumn,
+		"checks":        checksColumn,
+		"failingChecks": failingChecksColumn,
+		"mergeable":     mergeableColumn,
+		"approved":      approvedColumn,
+		"draft":         draftColumn,
+		"title":         titleColumn,
+		"url":           urlColumn,
+		"author":        authorColumn,
+		"repository":    repositoryColumn,
+		"host":          hostColumn,
+		"change":        changeColumn,
+		"state":         stateColumn,
+		"comments":      commentsColumn,
+		"updatedAt":     updatedAtColumn,
 	}
 	columnIndex_title = map[Column]string{
-		checksColumn:     "C",
-		mergeableColumn:  "M",
-		approvedColumn:   "A",
-		draftColumn:      "D",
-		titleColumn:      "Title",
-		urlColumn:        "Url",
-		authorColumn:     "Author",
-		repositoryColumn: "Repository",
-		changeColumn:     "Change",
-		stateColumn:      "State",
-		commentsColumn:   "Comments",
-		updatedAtColumn:  "UpdatedAt",
+		checksColumn:        "C",
+		failingChecksColumn: "Failing",
+		mergeableColumn:     "M",
+		approvedColumn:      "A",
+		draftColumn:         "D",
+		titleColumn:         "Title",
+		urlColumn:           "Url",
+		authorColumn:        "Author",
+		repositoryColumn:    "Repository",
+		hostColumn:          "Host",
+		changeColumn:        "Change",
+		stateColumn:         "State",
+		commentsColumn:      "Comments",
+		updatedAtColumn:     "UpdatedAt",
 	}
 	columnIndex_minWidth = map[Column]int{
-		checksColumn:     2,
-		mergeableColumn:  2,
-		approvedColumn:   2,
-		draftColumn:      2,
-		titleColumn:      5,
-		urlColumn:        5,
-		authorColumn:     6,
-		repositoryColumn: 10,
-		changeColumn:     6,
-		stateColumn:      5,
-		commentsColumn:   5,
-		updatedAtColumn:  10,
+		checksColumn:        2,
+		failingChecksColumn: 7,
+		mergeableColumn:     2,
+		approvedColumn:      2,
+		draftColumn:         2,
+		titleColumn:         5,
+		urlColumn:           5,
+		authorColumn:        6,
+		repositoryColumn:    10,
+		hostColumn:          4,
+		changeColumn:        6,
+		stateColumn:         5,
+		commentsColumn:      5,
+		updatedAtColumn:     10,
 	}
 	columnIndex_maxWidth = map[Column]int{
-		checksColumn:     2,
-		mergeableColumn:  2,
-		approvedColumn:   2,
-		draftColumn:      2,
-		titleColumn:      math.MaxInt,
-		urlColumn:        math.MaxInt,
-		authorColumn:     math.MaxInt,
-		repositoryColumn: math.MaxInt,
-		changeColumn:     math.MaxInt,
-		stateColumn:      math.MaxInt,
-		commentsColumn:   math.MaxInt,
-		updatedAtColumn:  math.MaxInt,
+		checksColumn:        2,
+		failingChecksColumn: math.MaxInt,
+		mergeableColumn:     2,
+		approvedColumn:      2,
+		draftColumn:         2,
+		titleColumn:         math.MaxInt,
+		urlColumn:           math.MaxInt,
+		authorColumn:        math.MaxInt,
+		repositoryColumn:    math.MaxInt,
+		hostColumn:          math.MaxInt,
+		changeColumn:        math.MaxInt,
+		stateColumn:         math.MaxInt,
+		commentsColumn:      math.MaxInt,
+		updatedAtColumn:     math.MaxInt,
 	}
 	defaultDefaultColumns = []Column{
 		checksColumn,
@@ -111,6 +123,7 @@ var (
 	}
 	defaultWideColumns = []Column{
 		checksColumn,
+		failingChecksColumn,
 		mergeableColumn,
 		approvedColumn,
 		draftColumn,
@@ -118,6 +131,7 @@ var (
 		urlColumn,
 		authorColumn,
 		repositoryColumn,
+		hostColumn,
 		changeColumn,
 		stateColumn,
 		commentsColumn,
@@ -140,14 +154,15 @@ func Map[V, U any](mapFn func(V) U, s iter.Seq[V]) iter.Seq[U] {
 }
 
 func parseColumnIndex(s string) (Column, error) {
-	s = strings.TrimSpace(strings.ToLower(s))
-	value, present := column_value[s]
-	if !present {
-		names := Map(Column.String, maps.Keys(columnIndex_name))
-		validColumns := strings.Join(slices.Sorted(names), ", ")
-		return 0, fmt.Errorf("unknown column: %s (must be one of %s)", s, validColumns)
+	s = strings.TrimSpace(s)
+	for name, value := range column_value {
+		if strings.EqualFold(name, s) {
+			return value, nil
+		}
 	}
-	return value, nil
+	names := Map(Column.String, maps.Keys(columnIndex_name))
+	validColumns := strings.Join(slices.Sorted(names), ", ")
+	return 0, fmt.Errorf("unknown column: %s (must be one of %s)", s, validColumns)
 }
 
 func (i *Column) MarshalJSON() ([]byte, error) {
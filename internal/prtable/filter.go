@@ -0,0 +1,117 @@
+package prtable
+
+import (
+	"strings"
+
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/sahilm/fuzzy"
+)
+
+// Filter narrows the rows of a table to the pull requests matching every one
+// of its terms. Plain words are fuzzy matched against the title, author and
+// repository. Structured terms match a single field:
+//
+//	author:<login>                   the author contains <login>
+//	repo:<name>                      the repository contains <name>
+//	checks:failing|passing|pending   the combined check state
+//	is:draft                         the pull request is a draft
+//
+// Any term can be negated with a leading "-", and "-draft" is short for
+// "-is:draft". The zero value matches everything.
+type Filter struct {
+	text  string
+	terms []filterTerm
+}
+
+type filterTerm struct {
+	negate bool
+	key    string
+	value  string
+}
+
+// ParseFilter parses the given filter text.
+func ParseFilter(text string) Filter {
+	f := Filter{text: strings.TrimSpace(text)}
+	for _, word := range strings.Fields(text) {
+		term := filterTerm{}
+		if len(word) > 1 && strings.HasPrefix(word, "-") {
+			term.negate = true
+			word = word[1:]
+		}
+		if term.negate && word == "draft" {
+			word = "is:draft"
+		}
+		key, value, found := strings.Cut(word, ":")
+		switch {
+		case found && value != "" && isFilterKey(key):
+			term.key = strings.ToLower(key)
+			term.value = strings.ToLower(value)
+		default:
+			term.value = strings.ToLower(word)
+		}
+		f.terms = append(f.terms, term)
+	}
+	return f
+}
+
+// String returns the text the filter was parsed from.
+func (f Filter) String() string {
+	return f.text
+}
+
+// IsEmpty returns true when the filter matches everything.
+func (f Filter) IsEmpty() bool {
+	return len(f.terms) == 0
+}
+
+// Match returns true when the given pull request matches every term.
+func (f Filter) Match(pr github.PullRequest) bool {
+	for _, term := range f.terms {
+		if term.match(pr) == term.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func isFilterKey(key string) bool {
+	switch strings.ToLower(key) {
+	case "author", "repo", "checks", "is":
+		return true
+	default:
+		return false
+	}
+}
+
+func (t filterTerm) match(pr github.PullRequest) bool {
+	switch t.key {
+	case "author":
+		return strings.Contains(strings.ToLower(pr.Author.Login), t.value)
+	case "repo":
+		return strings.Contains(strings.ToLower(pr.Repository.NameWithOwner), t.value)
+	case "checks":
+		return checksMatch(pr.StatusCheckRollup.State, t.value)
+	case "is":
+		return t.value == "draft" && pr.IsDraft
+	default:
+		fields := []string{
+			strings.ToLower(pr.Title),
+			strings.ToLower(pr.Author.Login),
+			strings.ToLower(pr.Repository.NameWithOwner),
+		}
+		return len(fuzzy.Find(t.value, fields)) != 0
+	}
+}
+
+func checksMatch(state string, value string) bool {
+	switch value {
+	case "failing", "failure", "failed":
+		return state == "FAILURE" || state == "ERROR"
+	case "passing", "success", "passed":
+		return state == "SUCCESS"
+	case "pending", "running":
+		return state == "PENDING" || state == "EXPECTED"
+	default:
+		return false
+	}
+}
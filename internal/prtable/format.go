@@ -0,0 +1,236 @@
+package prtable
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mrxk/gh-my/internal/github"
+)
+
+// Format identifies how pull requests are printed outside of the TUI.
+type Format int
+
+const (
+	TableFormat Format = iota
+	JSONFormat
+	CSVFormat
+	MarkdownFormat
+	TSVFormat
+)
+
+var (
+	format_name = map[Format]string{
+		TableFormat:    "table",
+		JSONFormat:     "json",
+		CSVFormat:      "csv",
+		MarkdownFormat: "markdown",
+		TSVFormat:      "tsv",
+	}
+	format_value = map[string]Format{
+		"table":    TableFormat,
+		"json":     JSONFormat,
+		"csv":      CSVFormat,
+		"markdown": MarkdownFormat,
+		"tsv":      TSVFormat,
+	}
+)
+
+func (f Format) String() string {
+	return format_name[f]
+}
+
+// ParseFormat returns the format with the given name.
+func ParseFormat(s string) (Format, error) {
+	f, ok := format_value[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		names := strings.Join(slices.Sorted(maps.Keys(format_value)), ", ")
+		return 0, fmt.Errorf("unknown format: %s (must be one of %s)", s, names)
+	}
+	return f, nil
+}
+
+// columnIndex_value returns the underlying value of each column, as printed in
+// JSON output.
+var columnIndex_value = map[Column]func(pr github.PullRequest) any{
+	checksColumn: func(pr github.PullRequest) any {
+		return pr.StatusCheckRollup.State
+	},
+	failingChecksColumn: func(pr github.PullRequest) any {
+		names := []string{}
+		for _, check := range pr.FailingChecks() {
+			names = append(names, check.DisplayName())
+		}
+		return names
+	},
+	mergeableColumn: func(pr github.PullRequest) any {
+		return map[string]string{
+			"mergeable":        pr.Mergeable,
+			"mergeStateStatus": pr.MergeStateStatus,
+		}
+	},
+	approvedColumn: func(pr github.PullRequest) any {
+		return pr.ReviewDecision
+	},
+	draftColumn: func(pr github.PullRequest) any {
+		return pr.IsDraft
+	},
+	titleColumn: func(pr github.PullRequest) any {
+		return pr.Title
+	},
+	urlColumn: func(pr github.PullRequest) any {
+		return pr.URL
+	},
+	authorColumn: func(pr github.PullRequest) any {
+		return pr.Author.Login
+	},
+	repositoryColumn: func(pr github.PullRequest) any {
+		return pr.Repository.NameWithOwner
+	},
+	hostColumn: func(pr github.PullRequest) any {
+		return pr.Host
+	},
+	changeColumn: func(pr github.PullRequest) any {
+		return map[string]int{
+			"changedFiles": pr.ChangedFiles,
+			"additions":    pr.Additions,
+			"deletions":    pr.Deletions,
+		}
+	},
+	stateColumn: func(pr github.PullRequest) any {
+		return pr.State
+	},
+	commentsColumn: func(pr github.PullRequest) any {
+		return pr.TotalCommentsCount
+	},
+	updatedAtColumn: func(pr github.PullRequest) any {
+		return pr.UpdatedAt
+	},
+}
+
+// Write prints the given search results to w in the given format using the
+// columns and sort order of the default view, or of the wide view when wide is
+// true.
+func Write(w io.Writer, format Format, results github.PullRequestSearchResults, opts Options, wide bool) error {
+	t := New(nil, opts)
+	t.wideView = wide
+	p := asPage(results)
+	columns := t.activeColumns()
+	indexes := sortedIndexes(*t.activeSort(), p.prs)
+	switch format {
+	case JSONFormat:
+		return writeJSON(w, columns, indexes, p)
+	case CSVFormat:
+		return writeSeparated(w, ',', columns, indexes, p)
+	case TSVFormat:
+		return writeSeparated(w, '\t', columns, indexes, p)
+	case MarkdownFormat:
+		return writeMarkdown(w, columns, indexes, p)
+	default:
+		return writeTable(w, columns, indexes, p)
+	}
+}
+
+// Sorted returns the pull requests of the given search results in the sort
+// order of the default view, or of the wide view when wide is true.
+func Sorted(results github.PullRequestSearchResults, opts Options, wide bool) []github.PullRequest {
+	t := New(nil, opts)
+	t.wideView = wide
+	p := asPage(results)
+	prs := make([]github.PullRequest, 0, len(p.prs))
+	for _, i := range sortedIndexes(*t.activeSort(), p.prs) {
+		prs = append(prs, p.prs[i])
+	}
+	return prs
+}
+
+// writeJSON prints an array holding an object for each pull request with the
+// underlying value of each column keyed by column name.
+func writeJSON(w io.Writer, columns []Column, indexes []int, p *page) error {
+	objects := make([]map[string]any, 0, len(indexes))
+	for _, i := range indexes {
+		object := map[string]any{}
+		for _, col := range columns {
+			object[col.String()] = columnIndex_value[col](p.prs[i])
+		}
+		objects = append(objects, object)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(objects)
+}
+
+// writeSeparated prints a header with the column names followed by a record
+// for each pull request, with fields separated by the given rune.
+func writeSeparated(w io.Writer, comma rune, columns []Column, indexes []int, p *page) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	header := make([]string, 0, len(columns))
+	for _, col := range columns {
+		header = append(header, col.String())
+	}
+	records := [][]string{header}
+	for _, i := range indexes {
+		record := make([]string, 0, len(columns))
+		for _, col := range columns {
+			record = append(record, strings.TrimSpace(p.rows[i][col]))
+		}
+		records = append(records, record)
+	}
+	return writer.WriteAll(records)
+}
+
+// writeMarkdown prints a markdown table with the column titles as header.
+func writeMarkdown(w io.Writer, columns []Column, indexes []int, p *page) error {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	header := make([]string, 0, len(columns))
+	separator := make([]string, 0, len(columns))
+	for _, col := range columns {
+		header = append(header, columnIndex_title[col])
+		separator = append(separator, "---")
+	}
+	lines := []string{
+		"| " + strings.Join(header, " | ") + " |",
+		"| " + strings.Join(separator, " | ") + " |",
+	}
+	for _, i := range indexes {
+		cells := make([]string, 0, len(columns))
+		for _, col := range columns {
+			cells = append(cells, escape.Replace(strings.TrimSpace(p.rows[i][col])))
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+	}
+	_, err := fmt.Fprintln(w, strings.Join(lines, "\n"))
+	return err
+}
+
+// writeTable prints the rows as in the TUI, with the column titles as header
+// and the columns padded to the width of their widest cell.
+func writeTable(w io.Writer, columns []Column, indexes []int, p *page) error {
+	widths := make([]int, len(columns))
+	for c, col := range columns {
+		widths[c] = lipgloss.Width(columnIndex_title[col])
+		for _, i := range indexes {
+			widths[c] = max(widths[c], lipgloss.Width(p.rows[i][col]))
+		}
+	}
+	line := func(cell func(col Column) string) string {
+		cells := make([]string, 0, len(columns))
+		for c, col := range columns {
+			value := cell(col)
+			cells = append(cells, value+strings.Repeat(" ", widths[c]-lipgloss.Width(value)))
+		}
+		return strings.TrimRight(strings.Join(cells, "  "), " ")
+	}
+	lines := []string{line(func(col Column) string { return columnIndex_title[col] })}
+	for _, i := range indexes {
+		lines = append(lines, line(func(col Column) string { return p.rows[i][col] }))
+	}
+	_, err := fmt.Fprintln(w, strings.Join(lines, "\n"))
+	return err
+}
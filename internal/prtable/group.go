@@ -0,0 +1,136 @@
+package prtable
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mrxk/gh-my/internal/github"
+)
+
+// GroupBy identifies how the rows of a table are grouped under headings.
+type GroupBy int
+
+const (
+	NoGrouping GroupBy = iota
+	GroupByRepository
+	GroupByAuthor
+	GroupByReview
+	GroupByChecks
+)
+
+var (
+	groupBy_name = map[GroupBy]string{
+		NoGrouping:        "none",
+		GroupByRepository: "repository",
+		GroupByAuthor:     "author",
+		GroupByReview:     "review",
+		GroupByChecks:     "checks",
+	}
+	groupBy_value = map[string]GroupBy{
+		"none":       NoGrouping,
+		"repository": GroupByRepository,
+		"author":     GroupByAuthor,
+		"review":     GroupByReview,
+		"checks":     GroupByChecks,
+	}
+)
+
+func (g GroupBy) String() string {
+	return groupBy_name[g]
+}
+
+// next returns the grouping that follows this one when cycling through them.
+func (g GroupBy) next() GroupBy {
+	return (g + 1) % GroupBy(len(groupBy_name))
+}
+
+// label returns the heading of the group the given pull request belongs to.
+func (g GroupBy) label(pr github.PullRequest) string {
+	switch g {
+	case GroupByRepository:
+		return pr.Repository.NameWithOwner
+	case GroupByAuthor:
+		return pr.Author.Login
+	case GroupByReview:
+		if pr.ReviewDecision == "" {
+			return "no review decision"
+		}
+		return strings.ToLower(strings.ReplaceAll(pr.ReviewDecision, "_", " "))
+	case GroupByChecks:
+		switch checkRank(pr.StatusCheckRollup.State) {
+		case 0:
+			return "failing"
+		case 1:
+			return "pending"
+		case 2:
+			return "passing"
+		default:
+			return "no checks"
+		}
+	default:
+		return ""
+	}
+}
+
+func parseGroupBy(s string) (GroupBy, error) {
+	value, present := groupBy_value[strings.TrimSpace(strings.ToLower(s))]
+	if !present {
+		names := slices.Sorted(maps.Keys(groupBy_value))
+		return 0, fmt.Errorf("unknown grouping: %s (must be one of %s)", s, strings.Join(names, ", "))
+	}
+	return value, nil
+}
+
+func (g *GroupBy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.String())
+}
+
+func (g *GroupBy) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	*g, err = parseGroupBy(s)
+	return err
+}
+
+// group is a heading and the indexes of the pull requests listed under it.
+type group struct {
+	label   string
+	members []int
+}
+
+// groupIndexes splits the given indexes into groups, in order of first
+// appearance so that the sort order of the rows also orders the groups.
+func groupIndexes(groupBy GroupBy, indexes []int, prs []github.PullRequest) []*group {
+	groups := []*group{}
+	byLabel := map[string]*group{}
+	for _, i := range indexes {
+		label := groupBy.label(prs[i])
+		g, present := byLabel[label]
+		if !present {
+			g = &group{label: label}
+			byLabel[label] = g
+			groups = append(groups, g)
+		}
+		g.members = append(g.members, i)
+	}
+	return groups
+}
+
+// checkState returns the combined check state of a group, which is the worst
+// state of its members.
+func (g *group) checkState(prs []github.PullRequest) string {
+	state := ""
+	for _, i := range g.members {
+		memberState := prs[i].StatusCheckRollup.State
+		if checkRank(memberState) < checkRank(state) {
+			state = memberState
+		}
+	}
+	return state
+}
@@ -0,0 +1,122 @@
+package prtable
+
+import (
+	"slices"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/theme"
+)
+
+// toggleMark marks or unmarks the pull request under the cursor and moves the
+// cursor to the next row.
+func (t *PRTable) toggleMark() {
+	pr, ok := t.SelectedPullRequest()
+	if !ok {
+		return
+	}
+	t.marked[pr.ID] = !t.marked[pr.ID]
+	t.updateModel(t.currentResults)
+	t.Model.MoveDown(1)
+}
+
+// toggleMarkAll marks every loaded pull request that is not hidden by a
+// snooze, or unmarks them all when they are all marked already.
+func (t *PRTable) toggleMarkAll() {
+	prs := []github.PullRequest{}
+	for _, pr := range t.loaded() {
+		if t.showSnoozed || !t.IsSnoozed(pr) {
+			prs = append(prs, pr)
+		}
+	}
+	t.setMarks(prs)
+}
+
+// toggleMarkMatching marks every shown pull request matching the filter, or
+// unmarks them all when they are all marked already.
+func (t *PRTable) toggleMarkMatching() {
+	matching := []github.PullRequest{}
+	for _, pr := range t.loaded() {
+		if t.visible(pr) {
+			matching = append(matching, pr)
+		}
+	}
+	t.setMarks(matching)
+}
+
+// setMarks marks the given pull requests unless they are all marked, in which
+// case they are unmarked.
+func (t *PRTable) setMarks(prs []github.PullRequest) {
+	mark := slices.ContainsFunc(prs, func(pr github.PullRequest) bool {
+		return !t.marked[pr.ID]
+	})
+	for _, pr := range prs {
+		t.marked[pr.ID] = mark
+	}
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// MarkedPullRequests returns the loaded pull requests that are marked, in the
+// order of the search results.
+func (t *PRTable) MarkedPullRequests() []github.PullRequest {
+	marked := []github.PullRequest{}
+	for _, pr := range t.loaded() {
+		if t.marked[pr.ID] {
+			marked = append(marked, pr)
+		}
+	}
+	return marked
+}
+
+// Unmark unmarks the pull request with the given ID.
+func (t *PRTable) Unmark(id string) {
+	if !t.marked[id] {
+		return
+	}
+	delete(t.marked, id)
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// marker returns the glyphs flagging the given row: pinned, marked, changed
+// since the previous refresh, unread and snoozed.
+func (t *PRTable) marker(item rowItem) string {
+	if item.header {
+		return ""
+	}
+	glyphs := theme.Current().Glyphs
+	marker := ""
+	if t.IsPinned(item.pr) {
+		marker += glyphs.Pinned
+	}
+	if t.marked[item.pr.ID] {
+		marker += glyphs.Marked
+	}
+	if t.changed[item.pr.ID] {
+		marker += glyphs.Changed
+	}
+	if t.unread(item.pr) {
+		marker += glyphs.Unread
+	}
+	if t.IsSnoozed(item.pr) {
+		marker += glyphs.Snoozed
+	}
+	return marker
+}
+
+// addMarkers prepends the marker of each item to its row when any row has a
+// marker and records the width of the marker column.
+func (t *PRTable) addMarkers(rows []table.Row) {
+	t.markerWidth = 0
+	for _, item := range t.items {
+		t.markerWidth = max(t.markerWidth, lipgloss.Width(t.marker(item)))
+	}
+	if t.markerWidth == 0 {
+		return
+	}
+	for i := range rows {
+		rows[i] = append(table.Row{t.marker(t.items[i])}, rows[i]...)
+	}
+}
@@ -0,0 +1,92 @@
+package prtable
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/state"
+)
+
+// SetPinned sets the pinned pull requests, keyed by URL. Pinned pull requests
+// are shown above the others, outside of any group.
+func (t *PRTable) SetPinned(pinned map[string]state.Pin) {
+	t.pinned = pinned
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// IsPinned returns true when the given pull request is pinned.
+func (t *PRTable) IsPinned(pr github.PullRequest) bool {
+	_, present := t.pinned[pr.URL]
+	return present
+}
+
+// SetPinnedPullRequests sets the pinned pull requests that the search of this
+// table did not find. They are shown along with the search results but do
+// not count towards their total.
+func (t *PRTable) SetPinnedPullRequests(prs []github.PullRequest) {
+	t.pinnedPRs = prs
+}
+
+// AddPullRequest adds the given pull request to the pinned ones shown along
+// with the search results, for example when it was pinned from another tab.
+// Nothing is done when it is shown already.
+func (t *PRTable) AddPullRequest(pr github.PullRequest) {
+	if slices.ContainsFunc(t.loaded(), func(other github.PullRequest) bool {
+		return other.ID == pr.ID
+	}) {
+		return
+	}
+	t.pinnedPRs = append(t.pinnedPRs, pr)
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// withPinned returns the given page along with the pinned pull requests that
+// its search did not find. Those that were unpinned since are left out.
+func (t *PRTable) withPinned(p *page) *page {
+	if p == nil || len(t.pinnedPRs) == 0 {
+		return p
+	}
+	shown := &page{
+		columnWidths: maps.Clone(p.columnWidths),
+		rows:         slices.Clone(p.rows),
+		prs:          slices.Clone(p.prs),
+		total:        p.total,
+	}
+	for _, pr := range t.pinnedPRs {
+		if t.IsPinned(pr) && !slices.ContainsFunc(shown.prs, func(other github.PullRequest) bool {
+			return other.ID == pr.ID
+		}) {
+			shown.add(pr)
+		}
+	}
+	return shown
+}
+
+// loaded returns the pull requests shown by the table before filtering: the
+// search results followed by the pinned pull requests it did not find.
+func (t *PRTable) loaded() []github.PullRequest {
+	shown := t.withPinned(t.currentResults)
+	if shown == nil {
+		return nil
+	}
+	return shown.prs
+}
+
+// pinnedFirst moves the indexes of pinned pull requests before the others,
+// keeping their order otherwise. The number of pinned pull requests is
+// returned along with the indexes.
+func (t *PRTable) pinnedFirst(indexes []int, prs []github.PullRequest) ([]int, int) {
+	pinned := []int{}
+	others := []int{}
+	for _, i := range indexes {
+		if t.IsPinned(prs[i]) {
+			pinned = append(pinned, i)
+		} else {
+			others = append(others, i)
+		}
+	}
+	return append(pinned, others...), len(pinned)
+}
@@ -2,75 +2,115 @@ package prtable
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/cli/go-gh/pkg/text"
 	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/keys"
+	"github.com/mrxk/gh-my/internal/state"
+	"github.com/mrxk/gh-my/internal/theme"
 	"github.com/sassoftware/sas-ggdk/pkg/result"
 )
 
 type PRTable struct {
 	table.Model
-	reloadCommand  tea.Cmd
+	reloadCommand  func() tea.Cmd
 	needReload     bool
 	loading        bool
 	wideView       bool
 	err            error
 	defaultColumns []Column
 	wideColumns    []Column
+	defaultSort    *Sort
+	wideSort       *Sort
 	currentResults *page
-	urls           []string
-}
-
-var keyMap = table.KeyMap{
-	LineUp: key.NewBinding(
-		key.WithKeys("up", "k"),
-		key.WithHelp("↑/k", "up"),
-	),
-	LineDown: key.NewBinding(
-		key.WithKeys("down", "j"),
-		key.WithHelp("↓/j", "down"),
-	),
-	PageUp: key.NewBinding(
-		key.WithKeys("pgup"),
-		key.WithHelp("pgup", "page up"),
-	),
-	PageDown: key.NewBinding(
-		key.WithKeys("pgdown", " "),
-		key.WithHelp("pgdn", "page down"),
-	),
-	GotoTop: key.NewBinding(
-		key.WithKeys("home", "g"),
-		key.WithHelp("g/home", "go to start"),
-	),
-	GotoBottom: key.NewBinding(
-		key.WithKeys("end", "G"),
-		key.WithHelp("G/end", "go to end"),
-	),
-}
-
-func New(reloadCommand tea.Cmd, defaultColumns []Column, wideColumns []Column) *PRTable {
+	pinnedPRs      []github.PullRequest
+	items          []rowItem
+	matched        int
+	labelWidth     int
+	markerWidth    int
+	marked         map[string]bool
+	changed        map[string]bool
+	queryChanged   bool
+	selectedID     string
+	seen           map[string]state.Seen
+	snoozed        map[string]state.Snooze
+	pinned         map[string]state.Pin
+	showSnoozed    bool
+	hidden         int
+	cellWidths     map[Column]int
+	filter         Filter
+	groupBy        GroupBy
+	collapsed      map[string]bool
+	keys           keys.KeyMap
+}
+
+// ReloadMsg asks the focused table to fetch its pull requests again and the
+// other tables to fetch theirs the next time they gain focus.
+type ReloadMsg struct{}
+
+// rowItem is what a row of the table shows: either a pull request or the
+// heading of a group.
+type rowItem struct {
+	pr     github.PullRequest
+	header bool
+	group  string
+}
+
+// Options configures the columns and sort order of the default and wide views
+// of a table. Views without columns use the built in ones and views without a
+// sort keep the order of the search results.
+type Options struct {
+	DefaultColumns []Column
+	WideColumns    []Column
+	DefaultSort    *Sort
+	WideSort       *Sort
+	GroupBy        GroupBy
+	// Keys are the key bindings of the table, the built in ones when nil.
+	Keys *keys.KeyMap
+}
+
+// New returns a table fetching its pull requests with the command returned by
+// reloadCommand, which is called on the UI goroutine for every reload.
+func New(reloadCommand func() tea.Cmd, opts Options) *PRTable {
+	defaultColumns := opts.DefaultColumns
 	if len(defaultColumns) == 0 {
 		defaultColumns = defaultDefaultColumns
 	}
+	wideColumns := opts.WideColumns
 	if len(wideColumns) == 0 {
 		wideColumns = defaultWideColumns
 	}
-	return &PRTable{
-		Model: table.New(
-			table.WithKeyMap(keyMap),
-			table.WithColumns(asTableColumns(defaultColumns)),
-		),
+	keyMap := keys.Default()
+	if opts.Keys != nil {
+		keyMap = *opts.Keys
+	}
+	t := &PRTable{
+		keys:           keyMap,
 		needReload:     true,
 		reloadCommand:  reloadCommand,
 		defaultColumns: defaultColumns,
 		wideColumns:    wideColumns,
-		urls:           []string{},
-	}
+		defaultSort:    opts.DefaultSort,
+		wideSort:       opts.WideSort,
+		items:          []rowItem{},
+		groupBy:        opts.GroupBy,
+		collapsed:      map[string]bool{},
+		marked:         map[string]bool{},
+		changed:        map[string]bool{},
+	}
+	t.Model = table.New(
+		table.WithKeyMap(keyMap.Table()),
+		table.WithStyles(theme.Current().TableStyles()),
+		table.WithColumns(t.asTableColumns(defaultColumns)),
+	)
+	return t
 }
 
 func (t *PRTable) Status() string {
@@ -80,7 +120,20 @@ func (t *PRTable) Status() string {
 	if t.err != nil {
 		return t.err.Error()
 	}
-	return fmt.Sprintf("%d issues", len(t.Model.Rows()))
+	status := fmt.Sprintf("%d issues", t.matched)
+	switch {
+	case t.currentResults != nil && !t.filter.IsEmpty():
+		status = fmt.Sprintf("%d/%d shown", t.matched, len(t.currentResults.rows))
+	case t.currentResults != nil && len(t.currentResults.rows) < t.currentResults.total:
+		status = fmt.Sprintf("%d of %d loaded", len(t.currentResults.rows), t.currentResults.total)
+	}
+	if marked := len(t.MarkedPullRequests()); marked != 0 {
+		status += fmt.Sprintf(", %d marked", marked)
+	}
+	if t.hidden != 0 {
+		status += fmt.Sprintf(", %d snoozed", t.hidden)
+	}
+	return status
 }
 
 // View implements tea.Model.
@@ -101,43 +154,73 @@ func (t *PRTable) Update(msg tea.Msg) (*PRTable, tea.Cmd) {
 // gains focus.
 func (t *PRTable) unfocusedUpdate(msg tea.Msg) (*PRTable, tea.Cmd) {
 	switch typedMsg := msg.(type) {
+	case ReloadMsg:
+		t.invalidate()
 	case tea.KeyMsg:
-		switch typedMsg.String() {
-		case "c":
-			fallthrough
-		case "d":
-			fallthrough
-		case "r":
-			t.needReload = true
-			t.Model.SetRows(nil)
-		case "u":
-			fallthrough
-		case "w":
+		switch {
+		case key.Matches(typedMsg, t.keys.ToggleClosed, t.keys.ToggleDrafts, t.keys.IndividualQuery):
+			t.queryChanged = true
+			t.invalidate()
+		case key.Matches(typedMsg, t.keys.Reload):
+			t.invalidate()
+		case key.Matches(typedMsg, t.keys.WideView):
 			t.toggleWideView()
+		case key.Matches(typedMsg, t.keys.ShowSnoozed):
+			t.toggleShowSnoozed()
 		}
 	}
 	return t, nil
 }
 
+// invalidate drops the rows of the table so that its pull requests are fetched
+// again the next time it gains focus.
+func (t *PRTable) invalidate() {
+	t.needReload = true
+	t.Model.SetRows(nil)
+	t.items = nil
+}
+
 // focusedUpdate handles updates to the model when focused.
 func (t *PRTable) focusedUpdate(msg tea.Msg) (*PRTable, tea.Cmd) {
 	cmds := []tea.Cmd{}
 	switch typedMsg := msg.(type) {
 	case result.Result[github.PullRequestSearchResults]:
 		t.handleSearchResults(typedMsg)
+	case ReloadMsg:
+		t.loading = true
+		cmds = append(cmds, t.reloadCommand())
 	case tea.KeyMsg:
-		switch typedMsg.String() {
-		case "r":
+		switch {
+		case key.Matches(typedMsg, t.keys.Reload):
 			t.loading = true
-			cmds = append(cmds, t.reloadCommand)
-		case "u":
-			fallthrough
-		case "w":
+			cmds = append(cmds, t.reloadCommand())
+		case key.Matches(typedMsg, t.keys.ToggleClosed, t.keys.ToggleDrafts, t.keys.IndividualQuery):
+			t.queryChanged = true
+		case key.Matches(typedMsg, t.keys.WideView):
 			t.toggleWideView()
+		case key.Matches(typedMsg, t.keys.Sort):
+			t.cycleSort()
+		case key.Matches(typedMsg, t.keys.FlipSort):
+			t.flipSort()
+		case key.Matches(typedMsg, t.keys.GroupBy):
+			t.cycleGroupBy()
+		case key.Matches(typedMsg, t.keys.Collapse):
+			t.toggleCollapsed()
+		case key.Matches(typedMsg, t.keys.CollapseAll):
+			t.toggleAllCollapsed()
+		case key.Matches(typedMsg, t.keys.Mark):
+			t.toggleMark()
+		case key.Matches(typedMsg, t.keys.MarkAll):
+			t.toggleMarkAll()
+		case key.Matches(typedMsg, t.keys.MarkMatching):
+			t.toggleMarkMatching()
+		case key.Matches(typedMsg, t.keys.ShowSnoozed):
+			t.toggleShowSnoozed()
 		}
 	}
 	newTable, tableCmd := t.Model.Update(msg)
 	t.Model = newTable
+	t.seeSelected()
 	cmds = append(cmds, tableCmd)
 	return t, tea.Batch(cmds...)
 }
@@ -149,11 +232,67 @@ func (t *PRTable) Focus() tea.Cmd {
 	if t.needReload {
 		t.needReload = false
 		t.loading = true
-		return t.reloadCommand
+		return t.reloadCommand()
 	}
 	return nil
 }
 
+// SetFilter shows only the rows matching the given filter. The filter is kept
+// when new results are loaded.
+func (t *PRTable) SetFilter(filter Filter) {
+	t.filter = filter
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// activeSort returns a pointer to the sort of the active view.
+func (t *PRTable) activeSort() **Sort {
+	if t.wideView {
+		return &t.wideSort
+	}
+	return &t.defaultSort
+}
+
+// cycleSort sorts the active view by its next column, in column order, and
+// returns to the order of the search results after the last column.
+func (t *PRTable) cycleSort() {
+	sort := t.activeSort()
+	columns := t.activeColumns()
+	next := 0
+	if *sort != nil {
+		next = slices.Index(columns, (*sort).Column) + 1
+	}
+	switch {
+	case next >= len(columns):
+		*sort = nil
+	case *sort == nil:
+		*sort = &Sort{Column: columns[next]}
+	default:
+		*sort = &Sort{Column: columns[next], Descending: (*sort).Descending}
+	}
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// flipSort reverses the sort direction of the active view.
+func (t *PRTable) flipSort() {
+	sort := t.activeSort()
+	if *sort == nil {
+		return
+	}
+	*sort = &Sort{Column: (*sort).Column, Descending: !(*sort).Descending}
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// activeColumns returns the columns of the active view.
+func (t *PRTable) activeColumns() []Column {
+	if t.wideView {
+		return t.wideColumns
+	}
+	return t.defaultColumns
+}
+
 func (t *PRTable) toggleWideView() {
 	t.wideView = !t.wideView
 	t.updateModel(t.currentResults)
@@ -170,6 +309,7 @@ func (t *PRTable) handleSearchResults(searchResults result.Result[github.PullReq
 		t.err = nil
 	}
 	page := result.MapNoError(asPage, searchResults)
+	page = result.MapNoError(t.trackChanges, page)
 	page = result.MapNoError(t.updateModel, page)
 	t.currentResults = page.MustGet()
 }
@@ -177,79 +317,265 @@ func (t *PRTable) handleSearchResults(searchResults result.Result[github.PullReq
 type page struct {
 	columnWidths map[Column]int
 	rows         []map[Column]string
+	prs          []github.PullRequest
+	total        int
 }
 
 func asPage(prs github.PullRequestSearchResults) *page {
 	p := &page{
 		columnWidths: map[Column]int{},
 		rows:         []map[Column]string{},
+		total:        prs.Data.Search.IssueCount,
 	}
 	for _, issue := range prs.Data.Search.Edges {
-		row := map[Column]string{
-			checksColumn:     checkEmoji(issue.Node.StatusCheckRollup.State),
-			mergeableColumn:  mergeableEmoji(issue.Node.Mergeable, issue.Node.MergeStateStatus),
-			approvedColumn:   reviewEmoji(issue.Node.ReviewDecision),
-			draftColumn:      draftEmoji(issue.Node.IsDraft),
-			titleColumn:      issue.Node.Title,
-			urlColumn:        issue.Node.URL,
-			authorColumn:     issue.Node.Author.Login,
-			repositoryColumn: shortenRepository(issue.Node.Repository.NameWithOwner),
-			changeColumn:     fmt.Sprintf("%4s (+%d/-%d)", fmt.Sprintf("%d", issue.Node.ChangedFiles), issue.Node.Additions, issue.Node.Deletions),
-			stateColumn:      stateEmoji(issue.Node.State),
-			commentsColumn:   fmt.Sprintf("%d", issue.Node.TotalCommentsCount),
-			updatedAtColumn:  timeAgo(issue.Node.UpdatedAt),
-		}
-		for columnIndex, columnValue := range row {
-			p.columnWidths[columnIndex] = max(p.columnWidths[columnIndex], len(columnValue))
-		}
-		p.rows = append(p.rows, row)
+		p.add(issue.Node)
 	}
 	return p
 }
 
-func (t *PRTable) updateModel(prs *page) *page {
-	t.Model.SetRows(nil)
-	t.setColumns(prs)
-	t.setRows(prs)
-	return prs
+// add appends the given pull request to the page.
+func (p *page) add(pr github.PullRequest) {
+	row := asRow(pr)
+	for columnIndex, columnValue := range row {
+		p.columnWidths[columnIndex] = max(p.columnWidths[columnIndex], lipgloss.Width(columnValue))
+	}
+	p.rows = append(p.rows, row)
+	p.prs = append(p.prs, pr)
+}
+
+// replace swaps the pull request with the same ID as the given one for it.
+// False is returned when the page does not hold that pull request.
+func (p *page) replace(pr github.PullRequest) bool {
+	i := slices.IndexFunc(p.prs, func(other github.PullRequest) bool {
+		return other.ID == pr.ID
+	})
+	if i < 0 {
+		return false
+	}
+	p.prs[i] = pr
+	p.rows[i] = asRow(pr)
+	for columnIndex, columnValue := range p.rows[i] {
+		p.columnWidths[columnIndex] = max(p.columnWidths[columnIndex], lipgloss.Width(columnValue))
+	}
+	return true
 }
 
-func (t *PRTable) setRows(prs *page) *page {
+func asRow(pr github.PullRequest) map[Column]string {
+	return map[Column]string{
+		checksColumn:        CheckEmoji(pr.StatusCheckRollup.State),
+		failingChecksColumn: failingChecks(pr.FailingChecks()),
+		mergeableColumn:     mergeableEmoji(pr.Mergeable, pr.MergeStateStatus),
+		approvedColumn:      reviewEmoji(pr.ReviewDecision),
+		draftColumn:         draftEmoji(pr.IsDraft),
+		titleColumn:         pr.Title,
+		urlColumn:           pr.URL,
+		authorColumn:        pr.Author.Login,
+		repositoryColumn:    ShortenRepository(pr.Repository.NameWithOwner),
+		hostColumn:          pr.Host,
+		changeColumn:        fmt.Sprintf("%4s (+%d/-%d)", fmt.Sprintf("%d", pr.ChangedFiles), pr.Additions, pr.Deletions),
+		stateColumn:         stateEmoji(pr.State),
+		commentsColumn:      fmt.Sprintf("%d", pr.TotalCommentsCount),
+		updatedAtColumn:     timeAgo(pr.UpdatedAt),
+	}
+}
+
+// UpdatePullRequest refreshes the row of the given pull request, for example
+// after it was changed from this tool. Tables without that pull request are
+// left as they are.
+func (t *PRTable) UpdatePullRequest(pr github.PullRequest) {
+	i := slices.IndexFunc(t.pinnedPRs, func(other github.PullRequest) bool {
+		return other.ID == pr.ID
+	})
+	if i >= 0 {
+		t.pinnedPRs[i] = pr
+	}
+	if t.currentResults == nil || (!t.currentResults.replace(pr) && i < 0) {
+		return
+	}
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// updateModel shows the given page. The columns are sized for the rows before
+// the rows are set since rows must not have more cells than there are columns.
+func (t *PRTable) updateModel(prs *page) *page {
+	t.Model.SetRows(nil)
 	if prs == nil {
 		return prs
 	}
-	selectedColumns := t.defaultColumns
-	if t.wideView {
-		selectedColumns = t.wideColumns
+	shown := t.withPinned(prs)
+	rows := t.buildRows(shown)
+	t.setColumns(shown)
+	t.Model.SetRows(rows)
+	// The table puts the cursor at -1 when it is moved without rows, so it is
+	// left alone until there are rows to put it on.
+	switch {
+	case len(rows) == 0:
+	case t.Model.Cursor() >= len(rows):
+		t.Model.SetCursor(len(rows) - 1)
+	case t.Model.Cursor() < 0:
+		t.Model.SetCursor(0)
 	}
-	t.urls = make([]string, 0, len(prs.rows))
-	rows := make([]table.Row, 0, len(prs.rows))
-	for _, inputRow := range prs.rows {
-		t.urls = append(t.urls, inputRow[urlColumn])
+	return prs
+}
+
+// buildRows returns the rows showing the given page with the active sort,
+// filter and grouping applied, pinned pull requests first, and records the
+// item shown by each row.
+func (t *PRTable) buildRows(prs *page) []table.Row {
+	selectedColumns := t.activeColumns()
+	indexes := []int{}
+	t.hidden = 0
+	for _, i := range sortedIndexes(*t.activeSort(), prs.prs) {
+		switch {
+		case t.visible(prs.prs[i]):
+			indexes = append(indexes, i)
+		case t.filter.Match(prs.prs[i]):
+			t.hidden++
+		}
+	}
+	t.matched = len(indexes)
+	indexes, pinned := t.pinnedFirst(indexes, prs.prs)
+	t.labelWidth = 0
+	t.cellWidths = map[Column]int{}
+	t.items = make([]rowItem, 0, len(indexes))
+	rows := make([]table.Row, 0, len(indexes))
+	appendPullRequest := func(i int, group string) {
+		t.items = append(t.items, rowItem{pr: prs.prs[i], group: group})
 		row := make([]string, 0, len(selectedColumns))
 		for _, col := range selectedColumns {
-			row = append(row, inputRow[col])
+			value := t.cell(prs, i, col)
+			t.cellWidths[col] = max(t.cellWidths[col], lipgloss.Width(value))
+			row = append(row, value)
 		}
 		rows = append(rows, row)
 	}
-	t.Model.SetRows(rows)
-	return prs
+	if t.groupBy == NoGrouping {
+		for _, i := range indexes {
+			appendPullRequest(i, "")
+		}
+	} else {
+		for _, i := range indexes[:pinned] {
+			appendPullRequest(i, "")
+		}
+		for _, g := range groupIndexes(t.groupBy, indexes[pinned:], prs.prs) {
+			t.items = append(t.items, rowItem{header: true, group: g.label})
+			rows = append(rows, t.headerRow(g, prs.prs, selectedColumns))
+			if t.collapsed[g.label] {
+				continue
+			}
+			for _, i := range g.members {
+				appendPullRequest(i, g.label)
+			}
+		}
+	}
+	t.addMarkers(rows)
+	return rows
+}
+
+// headerRow returns the row heading a group. It shows whether the group is
+// collapsed, its label and size in the first text column and its combined
+// check state in the checks column, or after the label when the view has no
+// checks column.
+func (t *PRTable) headerRow(g *group, prs []github.PullRequest, columns []Column) table.Row {
+	marker := theme.Current().Glyphs.Expanded
+	if t.collapsed[g.label] {
+		marker = theme.Current().Glyphs.Collapsed
+	}
+	label := fmt.Sprintf("%s %s (%d)", marker, g.label, len(g.members))
+	checks := CheckEmoji(g.checkState(prs))
+	if !slices.Contains(columns, checksColumn) {
+		label += " " + checks
+	}
+	t.labelWidth = max(t.labelWidth, lipgloss.Width(label))
+	labelColumn := labelColumnIndex(columns)
+	row := make(table.Row, len(columns))
+	for i, col := range columns {
+		switch {
+		case i == labelColumn:
+			row[i] = label
+		case col == checksColumn:
+			row[i] = checks
+		}
+	}
+	if labelColumn < 0 && len(row) != 0 {
+		row[0] = label
+	}
+	return row
+}
+
+// labelColumnIndex returns the index of the column showing group headings,
+// which is the first column wide enough for text.
+func labelColumnIndex(columns []Column) int {
+	return slices.IndexFunc(columns, func(col Column) bool {
+		return columnIndex_maxWidth[col] > 2
+	})
+}
+
+// cycleGroupBy groups the rows by the next grouping.
+func (t *PRTable) cycleGroupBy() {
+	t.groupBy = t.groupBy.next()
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// toggleCollapsed collapses or expands the group under the cursor. The
+// cursor moves to the group heading.
+func (t *PRTable) toggleCollapsed() {
+	row := t.Cursor()
+	if t.groupBy == NoGrouping || row < 0 || row >= len(t.items) || t.items[row].group == "" {
+		return
+	}
+	group := t.items[row].group
+	t.collapsed[group] = !t.collapsed[group]
+	t.updateModel(t.currentResults)
+	for i, item := range t.items {
+		if item.header && item.group == group {
+			t.Model.SetCursor(i)
+			break
+		}
+	}
+	t.Model.UpdateViewport()
+}
+
+// toggleAllCollapsed collapses every group unless they are all collapsed
+// already, in which case every group is expanded.
+func (t *PRTable) toggleAllCollapsed() {
+	if t.groupBy == NoGrouping {
+		return
+	}
+	collapse := slices.ContainsFunc(t.items, func(item rowItem) bool {
+		return item.header && !t.collapsed[item.group]
+	})
+	for _, item := range t.items {
+		if item.header {
+			t.collapsed[item.group] = collapse
+		}
+	}
+	t.updateModel(t.currentResults)
+	t.Model.SetCursor(0)
+	t.Model.UpdateViewport()
 }
 
 func (t *PRTable) setColumns(prs *page) *page {
 	if prs == nil {
 		return prs
 	}
-	selectedColumns := t.defaultColumns
-	if t.wideView {
-		selectedColumns = t.wideColumns
-	}
-	columns := make([]table.Column, 0, len(selectedColumns))
-	for _, col := range selectedColumns {
-		width := min(columnIndex_maxWidth[col], prs.columnWidths[col])
-		width = max(columnIndex_minWidth[col], width)
+	selectedColumns := t.activeColumns()
+	labelColumn := labelColumnIndex(selectedColumns)
+	columns := make([]table.Column, 0, len(selectedColumns)+1)
+	if t.markerWidth != 0 {
+		columns = append(columns, table.Column{Width: t.markerWidth})
+	}
+	for i, col := range selectedColumns {
+		title := t.columnTitle(col)
+		width := min(columnIndex_maxWidth[col], max(prs.columnWidths[col], t.cellWidths[col]))
+		width = max(columnIndex_minWidth[col], lipgloss.Width(title), width)
+		if i == labelColumn {
+			width = max(width, t.labelWidth)
+		}
 		columns = append(columns, table.Column{
-			Title: columnIndex_title[col],
+			Title: title,
 			Width: width,
 		})
 	}
@@ -258,30 +584,70 @@ func (t *PRTable) setColumns(prs *page) *page {
 }
 
 func (t *PRTable) GetSelectedPRURL() string {
+	pr, ok := t.SelectedPullRequest()
+	if !ok {
+		return ""
+	}
+	return pr.URL
+}
+
+// SelectedPullRequest returns the pull request under the cursor. False is
+// returned when the table is empty or the cursor is on a group heading.
+func (t *PRTable) SelectedPullRequest() (github.PullRequest, bool) {
 	row := t.Cursor()
-	if row >= 0 && row < len(t.urls) {
-		return t.urls[row]
+	if row >= 0 && row < len(t.items) && !t.items[row].header {
+		return t.items[row].pr, true
 	}
-	return ""
+	return github.PullRequest{}, false
 }
 
-func checkEmoji(value string) string {
+// CheckEmoji returns the glyph for a status check state. Errored checks are
+// shown as failed and expected ones as pending.
+func CheckEmoji(value string) string {
+	glyphs := theme.Current().Glyphs
 	switch value {
 	case "SUCCESS":
-		return "✅"
-	case "FAILURE":
-		return "❌"
-	case "PENDING":
-		return "⏳"
+		return glyphs.Success
+	case "FAILURE", "ERROR":
+		return glyphs.Failure
+	case "PENDING", "EXPECTED":
+		return glyphs.Pending
 	default:
 		return " "
 	}
 }
 
+// CheckOutcome returns the outcome of a status check state, to color its
+// glyph.
+func CheckOutcome(value string) theme.Outcome {
+	switch value {
+	case "SUCCESS":
+		return theme.Success
+	case "FAILURE", "ERROR":
+		return theme.Failure
+	case "PENDING", "EXPECTED":
+		return theme.Pending
+	default:
+		return theme.Neutral
+	}
+}
+
+// failingChecks names the given failed checks, for example "❌ lint, e2e".
+func failingChecks(checks []github.CheckContext) string {
+	if len(checks) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(checks))
+	for _, check := range checks {
+		names = append(names, check.DisplayName())
+	}
+	return CheckEmoji("FAILURE") + " " + strings.Join(names, ", ")
+}
+
 func reviewEmoji(value string) string {
 	switch value {
 	case "APPROVED":
-		return "✅"
+		return theme.Current().Glyphs.Approved
 	default:
 		return " "
 	}
@@ -289,38 +655,41 @@ func reviewEmoji(value string) string {
 
 func draftEmoji(value bool) string {
 	if value {
-		return "📝"
+		return theme.Current().Glyphs.Draft
 	}
 	return " "
 
 }
 
 func mergeableEmoji(mergeable, status string) string {
+	glyphs := theme.Current().Glyphs
 	switch mergeable {
 	case "CONFLICTING":
-		return "❌"
+		return glyphs.Conflicting
 	case "MERGEABLE":
 		if status == "BEHIND" {
-			return "⬆️"
+			return glyphs.Behind
 		}
-		return "✅"
+		return glyphs.Mergeable
 	default:
 		return " "
 	}
 }
 
 func stateEmoji(value string) string {
+	glyphs := theme.Current().Glyphs
 	switch value {
 	case "MERGED":
-		return "🚀"
+		return glyphs.Merged
 	case "CLOSED":
-		return "🗑️"
+		return glyphs.Closed
 	default:
 		return " "
 	}
 }
 
-func shortenRepository(value string) string {
+// ShortenRepository strips the owner from a repository name.
+func ShortenRepository(value string) string {
 	parts := strings.Split(value, "/")
 	if len(parts) == 1 {
 		return parts[0]
@@ -336,7 +705,16 @@ func timeAgo(timeSpec string) string {
 	if err != nil {
 		panic(err)
 	}
-	ago := time.Since(timeAgo)
+	return TimeAgo(timeAgo)
+}
+
+// TimeAgo describes how long ago the given time was, for example "2 hours
+// ago". The zero time is described as an empty string.
+func TimeAgo(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	ago := time.Since(t)
 	if ago < time.Minute {
 		return "just now"
 	}
@@ -355,11 +733,21 @@ func timeAgo(timeSpec string) string {
 	return text.Pluralize(int(ago.Hours()/24/365), "year") + " ago"
 }
 
-func asTableColumns(src []Column) []table.Column {
+// columnTitle returns the header of the given column, marked when the active
+// view is sorted by it.
+func (t *PRTable) columnTitle(col Column) string {
+	title := columnIndex_title[col]
+	if sort := *t.activeSort(); sort != nil && sort.Column == col {
+		title += sort.indicator()
+	}
+	return title
+}
+
+func (t *PRTable) asTableColumns(src []Column) []table.Column {
 	dst := make([]table.Column, 0, len(src))
 	for _, col := range src {
-		title := columnIndex_title[col]
-		width := columnIndex_minWidth[col]
+		title := t.columnTitle(col)
+		width := max(columnIndex_minWidth[col], lipgloss.Width(title))
 		dst = append(dst, table.Column{
 			Title: title,
 			Width: width,
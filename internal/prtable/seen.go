@@ -0,0 +1,45 @@
+package prtable
+
+import (
+	"fmt"
+
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/state"
+)
+
+// SetSeen sets what the user has seen of each pull request, keyed by URL.
+// Rows of pull requests that were never seen or that were updated since are
+// flagged as unread, and the comments column counts the comments added since.
+// A nil map disables both.
+func (t *PRTable) SetSeen(seen map[string]state.Seen) {
+	t.seen = seen
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// unread returns true when the given pull request was updated since the user
+// last looked at it.
+func (t *PRTable) unread(pr github.PullRequest) bool {
+	if t.seen == nil {
+		return false
+	}
+	seen, present := t.seen[pr.URL]
+	return !present || seen.Unread(pr)
+}
+
+// cell returns the value shown in the given column of the row of the i-th pull
+// request of the given page.
+func (t *PRTable) cell(prs *page, i int, col Column) string {
+	value := prs.rows[i][col]
+	if col != commentsColumn || t.seen == nil {
+		return value
+	}
+	seen, present := t.seen[prs.prs[i].URL]
+	if !present {
+		return value
+	}
+	if count := seen.NewComments(prs.prs[i]); count != 0 {
+		value = fmt.Sprintf("%s (+%d)", value, count)
+	}
+	return value
+}
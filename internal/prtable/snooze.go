@@ -0,0 +1,38 @@
+package prtable
+
+import (
+	"time"
+
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/state"
+)
+
+// SetSnoozed sets the snoozed pull requests, keyed by URL. Snoozed pull
+// requests are hidden unless showing them was toggled on.
+func (t *PRTable) SetSnoozed(snoozed map[string]state.Snooze) {
+	t.snoozed = snoozed
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// IsSnoozed returns true when the given pull request is snoozed now.
+func (t *PRTable) IsSnoozed(pr github.PullRequest) bool {
+	snooze, present := t.snoozed[pr.URL]
+	return present && snooze.Active(pr, time.Now())
+}
+
+// toggleShowSnoozed shows or hides the snoozed pull requests.
+func (t *PRTable) toggleShowSnoozed() {
+	t.showSnoozed = !t.showSnoozed
+	t.updateModel(t.currentResults)
+	t.Model.UpdateViewport()
+}
+
+// visible returns true when the given pull request is shown with the current
+// filter and snoozes.
+func (t *PRTable) visible(pr github.PullRequest) bool {
+	if !t.filter.Match(pr) {
+		return false
+	}
+	return t.showSnoozed || !t.IsSnoozed(pr)
+}
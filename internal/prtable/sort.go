@@ -0,0 +1,148 @@
+package prtable
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/theme"
+)
+
+// Sort orders the rows of a table by the underlying value of a column.
+type Sort struct {
+	Column     Column `json:"column"`
+	Descending bool   `json:"descending,omitempty"`
+}
+
+// indicator returns the marker shown in the header of the sorted column.
+func (s Sort) indicator() string {
+	if s.Descending {
+		return " " + theme.Current().Glyphs.Descending
+	}
+	return " " + theme.Current().Glyphs.Ascending
+}
+
+// compare orders two pull requests by the sort column.
+func (s Sort) compare(a, b github.PullRequest) int {
+	result := columnIndex_compare[s.Column](a, b)
+	if s.Descending {
+		return -result
+	}
+	return result
+}
+
+// sortedIndexes returns the indexes of the given pull requests in sorted
+// order. A nil sort keeps the order of the search results.
+func sortedIndexes(sort *Sort, prs []github.PullRequest) []int {
+	indexes := make([]int, len(prs))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	if sort == nil {
+		return indexes
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		return sort.compare(prs[a], prs[b])
+	})
+	return indexes
+}
+
+var columnIndex_compare = map[Column]func(a, b github.PullRequest) int{
+	checksColumn: func(a, b github.PullRequest) int {
+		return cmp.Compare(checkRank(a.StatusCheckRollup.State), checkRank(b.StatusCheckRollup.State))
+	},
+	failingChecksColumn: func(a, b github.PullRequest) int {
+		return cmp.Compare(len(a.FailingChecks()), len(b.FailingChecks()))
+	},
+	mergeableColumn: func(a, b github.PullRequest) int {
+		return cmp.Compare(mergeableRank(a), mergeableRank(b))
+	},
+	approvedColumn: func(a, b github.PullRequest) int {
+		return cmp.Compare(a.ReviewDecision, b.ReviewDecision)
+	},
+	draftColumn: func(a, b github.PullRequest) int {
+		return compareBool(a.IsDraft, b.IsDraft)
+	},
+	titleColumn: func(a, b github.PullRequest) int {
+		return compareFold(a.Title, b.Title)
+	},
+	urlColumn: func(a, b github.PullRequest) int {
+		return cmp.Compare(a.URL, b.URL)
+	},
+	authorColumn: func(a, b github.PullRequest) int {
+		return compareFold(a.Author.Login, b.Author.Login)
+	},
+	repositoryColumn: func(a, b github.PullRequest) int {
+		return compareFold(ShortenRepository(a.Repository.NameWithOwner), ShortenRepository(b.Repository.NameWithOwner))
+	},
+	hostColumn: func(a, b github.PullRequest) int {
+		return compareFold(a.Host, b.Host)
+	},
+	changeColumn: func(a, b github.PullRequest) int {
+		return cmp.Compare(a.Additions+a.Deletions, b.Additions+b.Deletions)
+	},
+	stateColumn: func(a, b github.PullRequest) int {
+		return cmp.Compare(a.State, b.State)
+	},
+	commentsColumn: func(a, b github.PullRequest) int {
+		return cmp.Compare(a.TotalCommentsCount, b.TotalCommentsCount)
+	},
+	updatedAtColumn: func(a, b github.PullRequest) int {
+		return ParseTime(a.UpdatedAt).Compare(ParseTime(b.UpdatedAt))
+	},
+}
+
+// checkRank orders check states from failing to passing.
+func checkRank(state string) int {
+	switch state {
+	case "FAILURE", "ERROR":
+		return 0
+	case "PENDING", "EXPECTED":
+		return 1
+	case "SUCCESS":
+		return 2
+	default:
+		return 3
+	}
+}
+
+// mergeableRank orders pull requests from conflicting to cleanly mergeable.
+func mergeableRank(pr github.PullRequest) int {
+	switch {
+	case pr.Mergeable == "CONFLICTING":
+		return 0
+	case pr.Mergeable == "MERGEABLE" && pr.MergeStateStatus == "BEHIND":
+		return 1
+	case pr.Mergeable == "MERGEABLE":
+		return 2
+	default:
+		return 3
+	}
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+func compareFold(a, b string) int {
+	return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// ParseTime parses an RFC3339 timestamp. Invalid timestamps yield the zero
+// time so that they sort first.
+func ParseTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
@@ -0,0 +1,112 @@
+// Package report renders pull requests with user supplied text templates.
+package report
+
+import (
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/prtable"
+)
+
+// PullRequest is the pull request given to templates. Its fields are kept
+// stable independently of the GraphQL queries that fill them.
+type PullRequest struct {
+	Host             string
+	Repository       string
+	Number           int
+	Title            string
+	URL              string
+	Author           string
+	State            string
+	IsDraft          bool
+	ReviewDecision   string
+	Checks           string
+	FailingChecks    []string
+	Mergeable        string
+	MergeStateStatus string
+	AutoMerge        bool
+	ChangedFiles     int
+	Additions        int
+	Deletions        int
+	Comments         int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// FromPullRequest converts a pull request returned by a search.
+func FromPullRequest(pr github.PullRequest) PullRequest {
+	failing := []string{}
+	for _, check := range pr.FailingChecks() {
+		failing = append(failing, check.DisplayName())
+	}
+	return PullRequest{
+		Host:             pr.Host,
+		Repository:       pr.Repository.NameWithOwner,
+		Number:           pr.Number,
+		Title:            pr.Title,
+		URL:              pr.URL,
+		Author:           pr.Author.Login,
+		State:            pr.State,
+		IsDraft:          pr.IsDraft,
+		ReviewDecision:   pr.ReviewDecision,
+		Checks:           pr.StatusCheckRollup.State,
+		FailingChecks:    failing,
+		Mergeable:        pr.Mergeable,
+		MergeStateStatus: pr.MergeStateStatus,
+		AutoMerge:        pr.AutoMergeRequest != nil,
+		ChangedFiles:     pr.ChangedFiles,
+		Additions:        pr.Additions,
+		Deletions:        pr.Deletions,
+		Comments:         pr.TotalCommentsCount,
+		CreatedAt:        prtable.ParseTime(pr.CreatedAt),
+		UpdatedAt:        prtable.ParseTime(pr.UpdatedAt),
+	}
+}
+
+// FromPullRequests converts the given pull requests, keeping their order.
+func FromPullRequests(prs []github.PullRequest) []PullRequest {
+	converted := make([]PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		converted = append(converted, FromPullRequest(pr))
+	}
+	return converted
+}
+
+// Funcs returns the helper functions available to templates.
+//
+//   - timeAgo: How long ago a time was, for example "2 hours ago".
+//   - checkEmoji: The glyph of a check state, as in the checks column.
+//   - shortenRepository: The repository name without its owner.
+//   - color: Renders text in a color such as "#ff0000" or "9", for example
+//     {{color "9" .Title}}. Colors are dropped when the output is not a
+//     terminal.
+//   - join: Joins strings with a separator, for example
+//     {{join ", " .FailingChecks}}.
+func Funcs() template.FuncMap {
+	return template.FuncMap{
+		"timeAgo":           prtable.TimeAgo,
+		"checkEmoji":        prtable.CheckEmoji,
+		"shortenRepository": prtable.ShortenRepository,
+		"color": func(color string, text string) string {
+			return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(text)
+		},
+		"join": func(sep string, elems []string) string {
+			return strings.Join(elems, sep)
+		},
+	}
+}
+
+// Parse parses the given template text with the helper functions.
+func Parse(text string) (*template.Template, error) {
+	return template.New("report").Funcs(Funcs()).Parse(text)
+}
+
+// Execute renders the template to w with the given pull requests, converted
+// to PullRequest, as data.
+func Execute(w io.Writer, tmpl *template.Template, prs []github.PullRequest) error {
+	return tmpl.Execute(w, FromPullRequests(prs))
+}
@@ -0,0 +1,51 @@
+package state
+
+import (
+	"github.com/mrxk/gh-my/internal/github"
+)
+
+// Pin keeps a pull request at the top of every tab. The host and node ID are
+// kept to fetch the pull request when no search finds it.
+type Pin struct {
+	URL  string
+	Host string
+	ID   string
+}
+
+// Pinned returns every pinned pull request, keyed by URL.
+func (s *Store) Pinned() (map[string]Pin, error) {
+	rows, err := s.db.Query(`SELECT url, host, id FROM pinned`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	pinned := map[string]Pin{}
+	for rows.Next() {
+		var record Pin
+		err = rows.Scan(&record.URL, &record.Host, &record.ID)
+		if err != nil {
+			return nil, err
+		}
+		pinned[record.URL] = record
+	}
+	return pinned, rows.Err()
+}
+
+// Pin pins the given pull request and returns the recorded pin.
+func (s *Store) Pin(pr github.PullRequest) (Pin, error) {
+	record := Pin{URL: pr.URL, Host: pr.Host, ID: pr.ID}
+	_, err := s.db.Exec(`
+		INSERT INTO pinned (url, host, id)
+		VALUES (?, ?, ?)
+		ON CONFLICT (url) DO UPDATE SET
+			host = excluded.host,
+			id = excluded.id`,
+		record.URL, record.Host, record.ID)
+	return record, err
+}
+
+// Unpin unpins the pull request with the given URL.
+func (s *Store) Unpin(url string) error {
+	_, err := s.db.Exec(`DELETE FROM pinned WHERE url = ?`, url)
+	return err
+}
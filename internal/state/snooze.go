@@ -0,0 +1,84 @@
+package state
+
+import (
+	"time"
+
+	"github.com/mrxk/gh-my/internal/github"
+)
+
+// Snooze hides a pull request until it is updated or, when Until is set,
+// until that time has passed.
+type Snooze struct {
+	URL       string
+	UpdatedAt string
+	Until     time.Time
+}
+
+// Active returns true when the given pull request is still snoozed at the
+// given time, that is when it was not updated since it was snoozed and the
+// snooze has not expired.
+func (s Snooze) Active(pr github.PullRequest, now time.Time) bool {
+	if !s.Until.IsZero() && !now.Before(s.Until) {
+		return false
+	}
+	snoozedAt, err := time.Parse(time.RFC3339, s.UpdatedAt)
+	if err != nil {
+		return pr.UpdatedAt == s.UpdatedAt
+	}
+	updatedAt, err := time.Parse(time.RFC3339, pr.UpdatedAt)
+	if err != nil {
+		return pr.UpdatedAt == s.UpdatedAt
+	}
+	return !updatedAt.After(snoozedAt)
+}
+
+// Snoozed returns every snoozed pull request, keyed by URL.
+func (s *Store) Snoozed() (map[string]Snooze, error) {
+	rows, err := s.db.Query(`SELECT url, updated_at, until FROM snoozed`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	snoozed := map[string]Snooze{}
+	for rows.Next() {
+		var record Snooze
+		var until string
+		err = rows.Scan(&record.URL, &record.UpdatedAt, &until)
+		if err != nil {
+			return nil, err
+		}
+		if until != "" {
+			record.Until, _ = time.Parse(time.RFC3339, until)
+		}
+		snoozed[record.URL] = record
+	}
+	return snoozed, rows.Err()
+}
+
+// Snooze snoozes the given pull request until it is updated or, when until is
+// not zero, until that time. It returns the recorded snooze.
+func (s *Store) Snooze(pr github.PullRequest, until time.Time) (Snooze, error) {
+	record := Snooze{
+		URL:       pr.URL,
+		UpdatedAt: pr.UpdatedAt,
+		Until:     until.UTC().Truncate(time.Second),
+	}
+	value := ""
+	if !until.IsZero() {
+		value = record.Until.Format(time.RFC3339)
+	}
+	_, err := s.db.Exec(`
+		INSERT INTO snoozed (url, updated_at, until)
+		VALUES (?, ?, ?)
+		ON CONFLICT (url) DO UPDATE SET
+			updated_at = excluded.updated_at,
+			until = excluded.until`,
+		record.URL, record.UpdatedAt, value)
+	return record, err
+}
+
+// Unsnooze brings back the pull request with the given URL.
+func (s *Store) Unsnooze(url string) error {
+	_, err := s.db.Exec(`DELETE FROM snoozed WHERE url = ?`, url)
+	return err
+}
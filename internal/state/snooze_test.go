@@ -0,0 +1,69 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mrxk/gh-my/internal/github"
+)
+
+func TestSnoozeActive(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		snooze    Snooze
+		updatedAt string
+		want      bool
+	}{
+		{
+			name:      "not updated",
+			snooze:    Snooze{UpdatedAt: "2024-03-09T08:00:00Z"},
+			updatedAt: "2024-03-09T08:00:00Z",
+			want:      true,
+		},
+		{
+			name:      "updated since",
+			snooze:    Snooze{UpdatedAt: "2024-03-09T08:00:00Z"},
+			updatedAt: "2024-03-09T08:00:01Z",
+			want:      false,
+		},
+		{
+			name:      "same time in another zone",
+			snooze:    Snooze{UpdatedAt: "2024-03-09T08:00:00Z"},
+			updatedAt: "2024-03-09T09:00:00+01:00",
+			want:      true,
+		},
+		{
+			name:      "before the date",
+			snooze:    Snooze{UpdatedAt: "2024-03-09T08:00:00Z", Until: now.Add(time.Second)},
+			updatedAt: "2024-03-09T08:00:00Z",
+			want:      true,
+		},
+		{
+			name:      "date passed",
+			snooze:    Snooze{UpdatedAt: "2024-03-09T08:00:00Z", Until: now},
+			updatedAt: "2024-03-09T08:00:00Z",
+			want:      false,
+		},
+		{
+			name:      "updated before the date",
+			snooze:    Snooze{UpdatedAt: "2024-03-09T08:00:00Z", Until: now.Add(time.Hour)},
+			updatedAt: "2024-03-10T11:00:00Z",
+			want:      false,
+		},
+		{
+			name:      "unparsable times compared as text",
+			snooze:    Snooze{UpdatedAt: "yesterday"},
+			updatedAt: "yesterday",
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := github.PullRequest{UpdatedAt: tt.updatedAt}
+			if got := tt.snooze.Active(pr, now); got != tt.want {
+				t.Errorf("Active() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
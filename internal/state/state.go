@@ -0,0 +1,159 @@
+// Package state keeps what the user has seen of each pull request, and which
+// pull requests are snoozed or pinned, in a local SQLite database so that it
+// survives restarts.
+package state
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mrxk/gh-my/internal/github"
+	_ "modernc.org/sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS seen (
+	url             TEXT PRIMARY KEY,
+	updated_at      TEXT NOT NULL,
+	comments        INTEGER NOT NULL,
+	head_commit     TEXT NOT NULL,
+	review_decision TEXT NOT NULL,
+	seen_at         TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS snoozed (
+	url        TEXT PRIMARY KEY,
+	updated_at TEXT NOT NULL,
+	until      TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS pinned (
+	url  TEXT PRIMARY KEY,
+	host TEXT NOT NULL,
+	id   TEXT NOT NULL
+);
+`
+
+// Store is the local state database.
+type Store struct {
+	db *sql.DB
+}
+
+// Seen is a snapshot of a pull request taken when the user last looked at
+// it.
+type Seen struct {
+	URL            string
+	UpdatedAt      string
+	Comments       int
+	HeadCommit     string
+	ReviewDecision string
+	SeenAt         time.Time
+}
+
+// Unread returns true when the given pull request was updated since this
+// snapshot was taken.
+func (s Seen) Unread(pr github.PullRequest) bool {
+	return pr.UpdatedAt != s.UpdatedAt
+}
+
+// NewComments returns the number of comments added to the given pull request
+// since this snapshot was taken.
+func (s Seen) NewComments(pr github.PullRequest) int {
+	return max(pr.TotalCommentsCount-s.Comments, 0)
+}
+
+// Matches returns true when this snapshot is up to date with the given pull
+// request.
+func (s Seen) Matches(pr github.PullRequest) bool {
+	return s.UpdatedAt == pr.UpdatedAt &&
+		s.Comments == pr.TotalCommentsCount &&
+		s.HeadCommit == pr.HeadRefOid &&
+		s.ReviewDecision == pr.ReviewDecision
+}
+
+// DefaultPath returns the location of the database under the XDG state
+// directory, ${XDG_STATE_HOME}/gh-my/state.db, defaulting to
+// ${HOME}/.local/state/gh-my/state.db.
+func DefaultPath() (string, error) {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(dir, "gh-my", "state.db"), nil
+}
+
+// Open opens, creating it when needed, the database at the given path.
+func Open(path string) (*Store, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows a single writer, so serialize access instead of failing
+	// with busy errors.
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(schema)
+	if err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Seen returns the snapshot of every pull request the user has looked at,
+// keyed by URL.
+func (s *Store) Seen() (map[string]Seen, error) {
+	rows, err := s.db.Query(`SELECT url, updated_at, comments, head_commit, review_decision, seen_at FROM seen`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	seen := map[string]Seen{}
+	for rows.Next() {
+		var record Seen
+		var seenAt string
+		err = rows.Scan(&record.URL, &record.UpdatedAt, &record.Comments, &record.HeadCommit, &record.ReviewDecision, &seenAt)
+		if err != nil {
+			return nil, err
+		}
+		record.SeenAt, _ = time.Parse(time.RFC3339, seenAt)
+		seen[record.URL] = record
+	}
+	return seen, rows.Err()
+}
+
+// MarkSeen records that the user looked at the given pull request now and
+// returns the recorded snapshot.
+func (s *Store) MarkSeen(pr github.PullRequest) (Seen, error) {
+	record := Seen{
+		URL:            pr.URL,
+		UpdatedAt:      pr.UpdatedAt,
+		Comments:       pr.TotalCommentsCount,
+		HeadCommit:     pr.HeadRefOid,
+		ReviewDecision: pr.ReviewDecision,
+		SeenAt:         time.Now().UTC().Truncate(time.Second),
+	}
+	_, err := s.db.Exec(`
+		INSERT INTO seen (url, updated_at, comments, head_commit, review_decision, seen_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (url) DO UPDATE SET
+			updated_at = excluded.updated_at,
+			comments = excluded.comments,
+			head_commit = excluded.head_commit,
+			review_decision = excluded.review_decision,
+			seen_at = excluded.seen_at`,
+		record.URL, record.UpdatedAt, record.Comments, record.HeadCommit, record.ReviewDecision, record.SeenAt.Format(time.RFC3339))
+	return record, err
+}
@@ -0,0 +1,174 @@
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// GlyphSet identifies the symbols used to show statuses.
+type GlyphSet int
+
+const (
+	EmojiGlyphs GlyphSet = iota
+	NerdFontGlyphs
+	ASCIIGlyphs
+)
+
+var (
+	glyphSet_name = map[GlyphSet]string{
+		EmojiGlyphs:    "emoji",
+		NerdFontGlyphs: "nerdFont",
+		ASCIIGlyphs:    "ascii",
+	}
+	glyphSet_value = map[string]GlyphSet{
+		"emoji":    EmojiGlyphs,
+		"nerdFont": NerdFontGlyphs,
+		"ascii":    ASCIIGlyphs,
+	}
+	glyphSet_glyphs = map[GlyphSet]Glyphs{
+		EmojiGlyphs: {
+			Success:          "✅",
+			Failure:          "❌",
+			Pending:          "⏳",
+			Approved:         "✅",
+			ChangesRequested: "❌",
+			Commented:        "💬",
+			Dismissed:        "🚫",
+			Draft:            "📝",
+			Mergeable:        "✅",
+			Conflicting:      "❌",
+			Behind:           "⬆️",
+			Merged:           "🚀",
+			Closed:           "🗑️",
+			Marked:           "✓",
+			Changed:          "●",
+			Unread:           "◆",
+			Snoozed:          "💤",
+			Pinned:           "📌",
+			Expanded:         "▾",
+			Collapsed:        "▸",
+			Ascending:        "▲",
+			Descending:       "▼",
+			Polling:          "🔄",
+		},
+		NerdFontGlyphs: {
+			Success:          "",
+			Failure:          "",
+			Pending:          "",
+			Approved:         "",
+			ChangesRequested: "",
+			Commented:        "",
+			Dismissed:        "",
+			Draft:            "",
+			Mergeable:        "",
+			Conflicting:      "",
+			Behind:           "",
+			Merged:           "",
+			Closed:           "",
+			Marked:           "",
+			Changed:          "",
+			Unread:           "",
+			Snoozed:          "",
+			Pinned:           "",
+			Expanded:         "",
+			Collapsed:        "",
+			Ascending:        "",
+			Descending:       "",
+			Polling:          "",
+		},
+		ASCIIGlyphs: {
+			Success:          "ok",
+			Failure:          "X",
+			Pending:          "..",
+			Approved:         "ok",
+			ChangesRequested: "X",
+			Commented:        "c",
+			Dismissed:        "-",
+			Draft:            "D",
+			Mergeable:        "ok",
+			Conflicting:      "X",
+			Behind:           "^",
+			Merged:           "M",
+			Closed:           "C",
+			Marked:           "+",
+			Changed:          "*",
+			Unread:           "o",
+			Snoozed:          "z",
+			Pinned:           "!",
+			Expanded:         "v",
+			Collapsed:        ">",
+			Ascending:        "^",
+			Descending:       "v",
+			Polling:          "~",
+		},
+	}
+)
+
+// Glyphs are the symbols shown for the statuses of pull requests, checks and
+// reviews, and for the flags of table rows.
+type Glyphs struct {
+	// Check states, also used for the combined state of a group.
+	Success string
+	Failure string
+	Pending string
+	// Review decisions and the states of individual reviews.
+	Approved         string
+	ChangesRequested string
+	Commented        string
+	Dismissed        string
+	// Pull request states.
+	Draft       string
+	Mergeable   string
+	Conflicting string
+	Behind      string
+	Merged      string
+	Closed      string
+	// Row flags.
+	Marked  string
+	Changed string
+	Unread  string
+	Snoozed string
+	Pinned  string
+	// Group headings and sort indicators.
+	Expanded   string
+	Collapsed  string
+	Ascending  string
+	Descending string
+	// Shown in the footer in watch mode.
+	Polling string
+}
+
+func (g GlyphSet) String() string {
+	return glyphSet_name[g]
+}
+
+// Glyphs returns the symbols of this set.
+func (g GlyphSet) Glyphs() Glyphs {
+	return glyphSet_glyphs[g]
+}
+
+func parseGlyphSet(s string) (GlyphSet, error) {
+	value, present := glyphSet_value[strings.TrimSpace(s)]
+	if !present {
+		names := slices.Sorted(maps.Keys(glyphSet_value))
+		return 0, fmt.Errorf("unknown glyph set: %s (must be one of %s)", s, strings.Join(names, ", "))
+	}
+	return value, nil
+}
+
+func (g *GlyphSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.String())
+}
+
+func (g *GlyphSet) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	*g, err = parseGlyphSet(s)
+	return err
+}
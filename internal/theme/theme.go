@@ -0,0 +1,122 @@
+// Package theme holds the colors and glyphs of the user interface. The theme
+// is chosen once at startup, with Use, and read wherever something is drawn.
+package theme
+
+import (
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Default colors.
+const (
+	defaultBorder   = "#6CB0D2"
+	defaultSelected = "212"
+	defaultSuccess  = "2"
+	defaultFailure  = "1"
+	defaultPending  = "3"
+)
+
+// Options configures a theme. Colors are ANSI color numbers, such as "9", or
+// hex codes, such as "#6CB0D2". Colors that are not set use the default ones
+// and the header uses the terminal color by default.
+type Options struct {
+	Glyphs   GlyphSet `json:"glyphs,omitempty"`
+	Border   string   `json:"border,omitempty"`
+	Header   string   `json:"header,omitempty"`
+	Selected string   `json:"selected,omitempty"`
+	Success  string   `json:"success,omitempty"`
+	Failure  string   `json:"failure,omitempty"`
+	Pending  string   `json:"pending,omitempty"`
+}
+
+// Theme is a set of glyphs along with the colors of borders, table headers,
+// the selected row and statuses.
+type Theme struct {
+	Glyphs   Glyphs
+	Border   lipgloss.TerminalColor
+	Header   lipgloss.TerminalColor
+	Selected lipgloss.TerminalColor
+	Success  lipgloss.TerminalColor
+	Failure  lipgloss.TerminalColor
+	Pending  lipgloss.TerminalColor
+}
+
+var current = New(Options{})
+
+// New returns the theme configured by the given options.
+func New(opts Options) Theme {
+	return Theme{
+		Glyphs:   opts.Glyphs.Glyphs(),
+		Border:   color(opts.Border, defaultBorder),
+		Header:   color(opts.Header, ""),
+		Selected: color(opts.Selected, defaultSelected),
+		Success:  color(opts.Success, defaultSuccess),
+		Failure:  color(opts.Failure, defaultFailure),
+		Pending:  color(opts.Pending, defaultPending),
+	}
+}
+
+// color returns the given color, or the fallback when it is not set. An empty
+// fallback means the terminal color.
+func color(value, fallback string) lipgloss.TerminalColor {
+	if value == "" {
+		value = fallback
+	}
+	if value == "" {
+		return lipgloss.NoColor{}
+	}
+	return lipgloss.Color(value)
+}
+
+// Use makes the given theme the current one. It must be called before
+// anything is drawn.
+func Use(t Theme) {
+	current = t
+}
+
+// Current returns the current theme.
+func Current() Theme {
+	return current
+}
+
+// TableStyles returns the styles of tables, with the header and selected row
+// colors of this theme.
+func (t Theme) TableStyles() table.Styles {
+	styles := table.DefaultStyles()
+	styles.Header = styles.Header.Foreground(t.Header)
+	styles.Selected = styles.Selected.Foreground(t.Selected)
+	return styles
+}
+
+// BorderStyle returns a style drawing the given sides of a border in the
+// border color of this theme.
+func (t Theme) BorderStyle(sides ...bool) lipgloss.Style {
+	return lipgloss.NewStyle().Border(lipgloss.NormalBorder(), sides...).BorderForeground(t.Border)
+}
+
+// Status renders the given glyph in the color of the given outcome: success,
+// failure or pending. Other outcomes are not colored. Status must not be used
+// for table cells since tables measure cells without ignoring colors.
+func (t Theme) Status(outcome Outcome, glyph string) string {
+	switch outcome {
+	case Success:
+		return lipgloss.NewStyle().Foreground(t.Success).Render(glyph)
+	case Failure:
+		return lipgloss.NewStyle().Foreground(t.Failure).Render(glyph)
+	case Pending:
+		return lipgloss.NewStyle().Foreground(t.Pending).Render(glyph)
+	default:
+		return glyph
+	}
+}
+
+// Outcome is the kind of a status, used to pick its color.
+type Outcome int
+
+// Possible outcomes.
+const (
+	Neutral Outcome = iota
+	Success
+	Failure
+	Pending
+)
@@ -2,15 +2,27 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/docopt/docopt-go"
+	"github.com/mrxk/gh-my/internal/github"
+	"github.com/mrxk/gh-my/internal/keys"
 	"github.com/mrxk/gh-my/internal/model"
+	"github.com/mrxk/gh-my/internal/notify"
 	"github.com/mrxk/gh-my/internal/prtable"
+	"github.com/mrxk/gh-my/internal/report"
+	"github.com/mrxk/gh-my/internal/state"
+	"github.com/mrxk/gh-my/internal/theme"
 	"github.com/sassoftware/sas-ggdk/pkg/jsonutils"
 )
 
@@ -25,19 +37,63 @@ Options:
 	-d, --include-drafts               Include draft PRs
 	-c, --include-closed               Include closed PRs
 	-w <interval>, --watch=<interval>  Poll every <interval>
+	-l <count>, --limit=<count>        Load at most <count> PRs per view
 	-f <path>, --config=<path>         Path to config file [default: ${XDG_CONFIG_HOME}/gh-my/config.json]
+	--record=<dir>                     Save every query and response to <dir>
+	--replay=<dir>                     Serve responses saved with --record from <dir>
+	--no-tui                           Print the PRs and exit instead of starting the TUI
+	--format=<format>                  Print the PRs as table, json, csv, markdown or tsv (implies --no-tui)
+	--wide                             Print the columns of the wide view
+	--template=<template>              Print the PRs with a Go template (implies --no-tui)
+	--template-file=<path>             Print the PRs with the Go template in <path> (implies --no-tui)
 	`
 )
 
 type Options struct {
 	startTab            model.TabIndex
-	IndividualRepoQuery bool             `json:"individualRepoQuery,omitempty"`
-	IncludeClosed       bool             `json:"includeClosed,omitempty"`
-	IncludeDrafts       bool             `json:"includeDrafts,omitempty"`
-	Interval            time.Duration    `json:"interval,omitempty"`
-	Repositories        []string         `json:"repositories,omitempty"`
-	DefaultView         []prtable.Column `json:"defaultView,omitempty"`
-	WideView            []prtable.Column `json:"wideView,omitempty"`
+	record              string
+	replay              string
+	noTUI               bool
+	format              prtable.Format
+	wide                bool
+	template            *template.Template
+	Backend             string              `json:"backend,omitempty"`
+	IndividualRepoQuery bool                `json:"individualRepoQuery,omitempty"`
+	IncludeClosed       bool                `json:"includeClosed,omitempty"`
+	IncludeDrafts       bool                `json:"includeDrafts,omitempty"`
+	Interval            time.Duration       `json:"interval,omitempty"`
+	Limit               int                 `json:"limit,omitempty"`
+	Concurrency         int                 `json:"concurrency,omitempty"`
+	Repositories        []string            `json:"repositories,omitempty"`
+	Hosts               []HostOptions       `json:"hosts,omitempty"`
+	Tabs                []TabOptions        `json:"tabs,omitempty"`
+	DefaultView         []prtable.Column    `json:"defaultView,omitempty"`
+	WideView            []prtable.Column    `json:"wideView,omitempty"`
+	DefaultSort         *prtable.Sort       `json:"defaultSort,omitempty"`
+	WideSort            *prtable.Sort       `json:"wideSort,omitempty"`
+	GroupBy             prtable.GroupBy     `json:"groupBy,omitempty"`
+	Notifications       *notify.Options     `json:"notifications,omitempty"`
+	Keys                map[string][]string `json:"keys,omitempty"`
+	Theme               theme.Options       `json:"theme,omitempty"`
+	keyMap              keys.KeyMap
+}
+
+// HostOptions configures a GitHub host and the repositories queried on it.
+type HostOptions struct {
+	Host         string   `json:"host"`
+	Repositories []string `json:"repositories,omitempty"`
+}
+
+// TabOptions configures a user defined tab backed by a saved search query.
+type TabOptions struct {
+	Name         string           `json:"name"`
+	Query        string           `json:"query"`
+	Repositories []string         `json:"repositories,omitempty"`
+	DefaultView  []prtable.Column `json:"defaultView,omitempty"`
+	WideView     []prtable.Column `json:"wideView,omitempty"`
+	DefaultSort  *prtable.Sort    `json:"defaultSort,omitempty"`
+	WideSort     *prtable.Sort    `json:"wideSort,omitempty"`
+	GroupBy      *prtable.GroupBy `json:"groupBy,omitempty"`
 }
 
 func parseArgs(usage string) (Options, error) {
@@ -50,6 +106,19 @@ func parseArgs(usage string) (Options, error) {
 	if path != "" {
 		opts = loadConfig(path)
 	}
+	for i, tab := range opts.Tabs {
+		if tab.Name == "" {
+			return opts, fmt.Errorf("tab %d: name is required", i+1)
+		}
+	}
+	err = validateNotifications(opts)
+	if err != nil {
+		return opts, err
+	}
+	opts.keyMap, err = keys.New(opts.Keys)
+	if err != nil {
+		return opts, err
+	}
 	includeDrafts, _ := docOpts.Bool("--include-drafts")
 	if includeDrafts {
 		opts.IncludeDrafts = true
@@ -70,6 +139,39 @@ func parseArgs(usage string) (Options, error) {
 		}
 		opts.Interval = duration
 	}
+	limit, _ := docOpts.String("--limit")
+	if limit != "" {
+		count, err := strconv.Atoi(limit)
+		if err != nil {
+			return opts, err
+		}
+		opts.Limit = count
+	}
+	opts.record, _ = docOpts.String("--record")
+	opts.replay, _ = docOpts.String("--replay")
+	if opts.record != "" && opts.replay != "" {
+		return opts, fmt.Errorf("--record and --replay cannot be used together")
+	}
+	opts.noTUI, _ = docOpts.Bool("--no-tui")
+	format, _ := docOpts.String("--format")
+	if format != "" {
+		opts.format, err = prtable.ParseFormat(format)
+		if err != nil {
+			return opts, err
+		}
+		opts.noTUI = true
+	}
+	opts.wide, _ = docOpts.Bool("--wide")
+	opts.template, err = parseTemplate(docOpts)
+	if err != nil {
+		return opts, err
+	}
+	if opts.template != nil {
+		if format != "" {
+			return opts, fmt.Errorf("--format and --template cannot be used together")
+		}
+		opts.noTUI = true
+	}
 	prs, _ := docOpts.Bool("prs")
 	requests, _ := docOpts.Bool("requests")
 	all, _ := docOpts.Bool("all")
@@ -84,6 +186,45 @@ func parseArgs(usage string) (Options, error) {
 	return opts, nil
 }
 
+// validateNotifications checks that the notification rules name existing
+// tabs.
+func validateNotifications(opts Options) error {
+	if opts.Notifications == nil {
+		return nil
+	}
+	names := slices.Clone(model.BuiltinTabNames)
+	for _, tab := range opts.Tabs {
+		names = append(names, tab.Name)
+	}
+	for _, rule := range opts.Notifications.Rules {
+		if !slices.Contains(names, rule.Tab) {
+			return fmt.Errorf("notification rule: unknown tab: %s (must be one of %s)", rule.Tab, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// parseTemplate returns the template given with --template or read from
+// --template-file, or nil when neither is given.
+func parseTemplate(docOpts docopt.Opts) (*template.Template, error) {
+	text, _ := docOpts.String("--template")
+	path, _ := docOpts.String("--template-file")
+	if text != "" && path != "" {
+		return nil, fmt.Errorf("--template and --template-file cannot be used together")
+	}
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		text = string(content)
+	}
+	if text == "" {
+		return nil, nil
+	}
+	return report.Parse(text)
+}
+
 func loadConfig(rawPath string) Options {
 	_, present := os.LookupEnv("XDG_CONFIG_HOME")
 	if !present {
@@ -102,23 +243,153 @@ func loadConfig(rawPath string) Options {
 	return optionsResult.MustGet()
 }
 
+// newHosts returns the hosts to query. When no hosts are configured the
+// default gh host is queried for the top level repositories. Otherwise the
+// repositories must be listed by host.
+func newHosts(opts Options) ([]model.Host, error) {
+	if len(opts.Hosts) != 0 && len(opts.Repositories) != 0 {
+		return nil, errors.New("repositories cannot be used along with hosts, list them in the repositories of each host instead")
+	}
+	hostOpts := opts.Hosts
+	if len(hostOpts) == 0 {
+		hostOpts = []HostOptions{{
+			Host:         github.DefaultHost(),
+			Repositories: opts.Repositories,
+		}}
+	}
+	hosts := make([]model.Host, 0, len(hostOpts))
+	for _, hostOpt := range hostOpts {
+		client, err := newClient(opts, hostOpt.Host, len(hostOpts) > 1)
+		if err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, model.Host{
+			Name:         hostOpt.Host,
+			Client:       client,
+			Repositories: hostOpt.Repositories,
+		})
+	}
+	return hosts, nil
+}
+
+// newClient returns the GraphQL client for the configured backend and the
+// given host. The http backend falls back to running the gh cli when no token
+// can be found. When replaying, recorded responses are served instead and no
+// backend is used. Recordings are kept in a directory per host when perHost is
+// true, and directly in the given directory otherwise.
+func newClient(opts Options, host string, perHost bool) (github.Client, error) {
+	fixtureDir := func(dir string) string {
+		if perHost {
+			return filepath.Join(dir, host)
+		}
+		return dir
+	}
+	if opts.replay != "" {
+		return github.NewReplayClient(fixtureDir(opts.replay))
+	}
+	var client github.Client
+	switch opts.Backend {
+	case "", "http":
+		httpClient, err := github.NewHTTPClient(host)
+		if err != nil {
+			client = github.NewExecClient(host)
+		} else {
+			client = httpClient
+		}
+	case "exec":
+		client = github.NewExecClient(host)
+	default:
+		return nil, fmt.Errorf("unknown backend: %s (must be one of exec, http)", opts.Backend)
+	}
+	if opts.record != "" {
+		return github.NewRecordingClient(client, fixtureDir(opts.record))
+	}
+	return client, nil
+}
+
+// openStore opens the state database at its default location.
+func openStore() (*state.Store, error) {
+	path, err := state.DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return state.Open(path)
+}
+
+// newTabs converts the configured user defined tabs into model tab options.
+func newTabs(opts Options) []model.TabOptions {
+	tabs := make([]model.TabOptions, 0, len(opts.Tabs))
+	for _, tab := range opts.Tabs {
+		tabs = append(tabs, model.TabOptions{
+			Name:         tab.Name,
+			Query:        tab.Query,
+			Repositories: tab.Repositories,
+			DefaultView:  tab.DefaultView,
+			WideView:     tab.WideView,
+			DefaultSort:  tab.DefaultSort,
+			WideSort:     tab.WideSort,
+			GroupBy:      tab.GroupBy,
+		})
+	}
+	return tabs
+}
+
 func main() {
 	opts, err := parseArgs(myUsage)
 	if err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	hosts, err := newHosts(opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	theme.Use(theme.New(opts.Theme))
+	var store *state.Store
+	if !opts.noTUI {
+		store, err = openStore()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "continuing without saved state: "+err.Error())
+		} else {
+			defer store.Close()
+		}
 	}
 	ctx, cancel := context.WithCancel(context.Background())
-	p := tea.NewProgram(model.New(model.Options{
+	m := model.New(model.Options{
 		Context:             ctx,
+		Hosts:               hosts,
 		IndividualRepoQuery: opts.IndividualRepoQuery,
 		IncludeClosed:       opts.IncludeClosed,
 		IncludeDrafts:       opts.IncludeDrafts,
 		Interval:            opts.Interval,
+		Limit:               opts.Limit,
+		Concurrency:         opts.Concurrency,
 		StartTab:            opts.startTab,
-		Repositories:        opts.Repositories,
 		DefaultView:         opts.DefaultView,
 		WideView:            opts.WideView,
-	}), tea.WithAltScreen())
+		DefaultSort:         opts.DefaultSort,
+		WideSort:            opts.WideSort,
+		GroupBy:             opts.GroupBy,
+		Tabs:                newTabs(opts),
+		Notifications:       opts.Notifications,
+		Store:               store,
+		Keys:                &opts.keyMap,
+	})
+	if opts.noTUI {
+		if opts.template != nil {
+			err = m.PrintTemplate(os.Stdout, opts.template)
+		} else {
+			err = m.Print(os.Stdout, opts.format, opts.wide)
+		}
+		cancel()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+	p := tea.NewProgram(m, tea.WithAltScreen())
 	_, err = p.Run()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())